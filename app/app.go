@@ -166,6 +166,7 @@ import (
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/middleware"
 	cronostypes "github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	e2eekeyring "github.com/crypto-org-chain/cronos/v2/x/e2ee/keyring"
+	"github.com/crypto-org-chain/cronos/versiondb"
 
 	e2ee "github.com/crypto-org-chain/cronos/v2/x/e2ee"
 	e2eekeeper "github.com/crypto-org-chain/cronos/v2/x/e2ee/keeper"
@@ -189,6 +190,15 @@ const (
 	FlagBlockedAddresses             = "blocked-addresses"
 	FlagUnsafeIgnoreBlockListFailure = "unsafe-ignore-block-list-failure"
 	FlagUnsafeDummyCheckTx           = "unsafe-dummy-check-tx"
+
+	FlagVersionDBVerifyInterval    = "versiondb.verify-interval"
+	FlagVersionDBSnapshotExtension = "versiondb.snapshot-extension"
+	FlagVersionDBSnapshotHistory   = "versiondb.snapshot-history"
+	FlagVersionDBPrimaryDir        = "versiondb.primary-dir"
+	FlagVersionDBCatchUpInterval   = "versiondb.catch-up-interval"
+	// FlagVersionDBDiscardSnapshotExtension is not under the versiondb switch, it applies to the nodes without
+	// versiondb too.
+	FlagVersionDBDiscardSnapshotExtension = "versiondb.discard-snapshot-extension"
 )
 
 var Forks = []Fork{}
@@ -352,6 +362,10 @@ type App struct {
 	configurator module.Configurator
 
 	qms storetypes.RootMultiStore
+	// versionDBSnapshotter is set when the versiondb snapshot extension is registered
+	versionDBSnapshotter bool
+	// closeSecondaryVersionDB stops catching up with the primary and closes the secondary versiondb, if opened
+	closeSecondaryVersionDB func()
 
 	blockProposalHandler *ProposalHandler
 
@@ -679,11 +693,39 @@ func New(
 		tracer,
 		evmS,
 		[]evmkeeper.CustomContractFn{
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return cronosprecompiles.NewBankContract(app.BankKeeper, &app.CronosKeeper, appCodec, gasConfig)
+			},
 			func(_ sdk.Context, rules ethparams.Rules) vm.PrecompiledContract {
 				return cronosprecompiles.NewRelayerContract(app.IBCKeeper, app.IBCFeeKeeper, appCodec, rules, app.Logger())
 			},
 			func(ctx sdk.Context, rules ethparams.Rules) vm.PrecompiledContract {
-				return cronosprecompiles.NewIcaContract(ctx, app.ICAControllerKeeper, &app.CronosKeeper, appCodec, gasConfig)
+				return cronosprecompiles.NewIcaContract(ctx, app.ICAControllerKeeper, app.IBCKeeper.ChannelKeeper, &app.CronosKeeper, appCodec, gasConfig)
+			},
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return cronosprecompiles.NewStakingContract(
+					stakingkeeper.NewMsgServerImpl(app.StakingKeeper),
+					distrkeeper.NewMsgServerImpl(app.DistrKeeper),
+					appCodec,
+					gasConfig,
+				)
+			},
+			func(ctx sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return cronosprecompiles.NewTransferContract(ctx, &app.CronosKeeper, gasConfig)
+			},
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return cronosprecompiles.NewGovContract(
+					govkeeper.NewMsgServerImpl(&app.GovKeeper),
+					govkeeper.NewQueryServer(&app.GovKeeper),
+					appCodec,
+					gasConfig,
+				)
+			},
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return cronosprecompiles.NewExecContract(app.MsgServiceRouter(), &app.CronosKeeper, appCodec, gasConfig)
+			},
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return cronosprecompiles.NewE2EEContract(app.E2EEKeeper, app.E2EEKeeper, gasConfig)
 			},
 		},
 	)
@@ -700,6 +742,16 @@ func New(
 		app.AccountKeeper,
 		authAddr,
 	)
+	// the log processor is set before the keeper is copied to the module, so the end blocker can retry the failed logs
+	logProcessEvmHook := cronoskeeper.NewLogProcessEvmHook(
+		evmhandlers.NewSendToAccountHandler(app.BankKeeper, app.CronosKeeper),
+		evmhandlers.NewSendToIbcHandler(app.BankKeeper, app.CronosKeeper),
+		evmhandlers.NewSendCroToIbcHandler(app.BankKeeper, app.CronosKeeper),
+		evmhandlers.NewSendToIbcV2Handler(app.BankKeeper, app.CronosKeeper),
+	).WithRegistry(evmhandlers.NewRegisteredLogHandlers(app.BankKeeper, app.CronosKeeper)).
+		WithRetryQueue(app.CronosKeeper)
+	app.CronosKeeper.SetLogProcessor(logProcessEvmHook)
+	app.EvmKeeper.SetHooks(logProcessEvmHook)
 	cronosModule := cronos.NewAppModule(app.CronosKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(cronostypes.ModuleName))
 
 	// register the proposal types
@@ -720,8 +772,10 @@ func New(
 
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
-	transferStack = middleware.NewIBCConversionModule(transferStack, app.CronosKeeper)
+	transferStack = middleware.NewIBCConversionModule(transferStack, app.IBCFeeKeeper, app.IBCKeeper.ChannelKeeper, app.CronosKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
+	// deliver the results of the packets sent by the transfer precompile to the calling contracts
+	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCFeeKeeper, app.CronosKeeper, math.MaxUint64)
 
 	govKeeper := govkeeper.NewKeeper(
 		appCodec,
@@ -739,13 +793,6 @@ func New(
 		),
 	)
 
-	app.EvmKeeper.SetHooks(cronoskeeper.NewLogProcessEvmHook(
-		evmhandlers.NewSendToAccountHandler(app.BankKeeper, app.CronosKeeper),
-		evmhandlers.NewSendToIbcHandler(app.BankKeeper, app.CronosKeeper),
-		evmhandlers.NewSendCroToIbcHandler(app.BankKeeper, app.CronosKeeper),
-		evmhandlers.NewSendToIbcV2Handler(app.BankKeeper, app.CronosKeeper),
-	))
-
 	var icaControllerStack porttypes.IBCModule
 	icaControllerStack = icacontroller.NewIBCMiddleware(nil, app.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
@@ -962,14 +1009,25 @@ func New(
 	// wire up the versiondb's `StreamingService` and `MultiStore`.
 	if cast.ToBool(appOpts.Get("versiondb.enable")) {
 		var err error
-		app.qms, err = app.setupVersionDB(homePath, keys, tkeys, memKeys, okeys)
+		app.qms, err = app.setupVersionDB(homePath, keys, tkeys, memKeys, okeys, appOpts)
 		if err != nil {
 			panic(err)
 		}
 	}
 
+	// the snapshot manager writes an item of every registered extension into the snapshots, so the discarding restorer
+	// is only registered on demand, to restore the snapshots carrying the versiondb extension without it.
+	if manager := app.SnapshotManager(); manager != nil && !app.versionDBSnapshotter &&
+		cast.ToBool(appOpts.Get(FlagVersionDBDiscardSnapshotExtension)) {
+		if err := manager.RegisterExtensions(versiondb.DiscardSnapshotter{}); err != nil {
+			panic(err)
+		}
+	}
+
+	// a read replica only serves queries from the secondary versiondb, baseapp checks the query heights against it,
+	// so the local store is loaded at its own version rather than the primary's.
 	var qmsVersion int64
-	if app.qms != nil {
+	if app.qms != nil && cast.ToString(appOpts.Get(FlagVersionDBPrimaryDir)) == "" {
 		qmsVersion = app.qms.LatestVersion()
 	}
 
@@ -1464,6 +1522,9 @@ func (app *App) Close() error {
 	if closer, ok := app.qms.(io.Closer); ok {
 		errs = append(errs, closer.Close())
 	}
+	if app.closeSecondaryVersionDB != nil {
+		app.closeSecondaryVersionDB()
+	}
 
 	// mainly to flush memiavl
 	if closer, ok := app.CommitMultiStore().(io.Closer); ok {
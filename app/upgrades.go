@@ -17,6 +17,9 @@ import (
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
+// NextPlanName is the name of the next upgrade plan
+const NextPlanName = "v1.5"
+
 // RegisterUpgradeHandlers returns if store loader is overridden
 func (app *App) RegisterUpgradeHandlers(cdc codec.BinaryCodec, maxVersion int64) bool {
 	planName := "v1.4"
@@ -43,6 +46,11 @@ func (app *App) RegisterUpgradeHandlers(cdc codec.BinaryCodec, maxVersion int64)
 		return m, nil
 	})
 
+	// runs the x/cronos migration from consensus version 2 to 3, which converts the legacy permissions to role grants.
+	app.UpgradeKeeper.SetUpgradeHandler(NextPlanName, func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 	// a hotfix upgrade plan just for testnet
 	hotfixPlanName := "v1.4.0-rc5-testnet"
 	app.UpgradeKeeper.SetUpgradeHandler(hotfixPlanName, func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
@@ -5,10 +5,14 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	tmrand "github.com/cometbft/cometbft/libs/rand"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/crypto-org-chain/cronos/v2/app"
+	cronoskeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	cronostypes "github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/stretchr/testify/suite"
 )
@@ -118,3 +122,21 @@ func (suite *AppTestSuite) TestUpdateExpeditedParams() {
 		})
 	}
 }
+
+func (suite *AppTestSuite) TestUpgradeMigratesPermissions() {
+	address := sdk.AccAddress(tmrand.Bytes(20))
+	// the legacy permission bitmask written before the consensus version 3
+	store := suite.ctx.KVStore(suite.app.GetKey(cronostypes.StoreKey))
+	store.Set(append(cronostypes.KeyPrefixAdminToPermissions, address...), sdk.Uint64ToBigEndian(cronoskeeper.All))
+
+	vm, err := suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)
+	suite.Require().NoError(err)
+	vm[cronostypes.ModuleName] = 2
+	suite.Require().NoError(suite.app.UpgradeKeeper.SetModuleVersionMap(suite.ctx, vm))
+	suite.Require().Zero(suite.app.CronosKeeper.GetPermissions(suite.ctx, address))
+
+	plan := upgradetypes.Plan{Name: app.NextPlanName, Height: suite.ctx.BlockHeight()}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, plan))
+	suite.Require().Equal(cronoskeeper.All, suite.app.CronosKeeper.GetPermissions(suite.ctx, address))
+	suite.Require().False(store.Has(append(cronostypes.KeyPrefixAdminToPermissions, address...)))
+}
@@ -4,21 +4,35 @@
 package app
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"sort"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
 	"github.com/crypto-org-chain/cronos/versiondb"
 	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
 )
 
+// defaultCatchUpInterval is the default interval for the secondary versiondb to catch up with the primary.
+const defaultCatchUpInterval = time.Second
+
 func (app *App) setupVersionDB(
 	homePath string,
 	keys map[string]*storetypes.KVStoreKey,
 	tkeys map[string]*storetypes.TransientStoreKey,
 	memKeys map[string]*storetypes.MemoryStoreKey,
 	okeys map[string]*storetypes.ObjectStoreKey,
+	appOpts servertypes.AppOptions,
 ) (storetypes.RootMultiStore, error) {
+	if primaryDir := cast.ToString(appOpts.Get(FlagVersionDBPrimaryDir)); primaryDir != "" {
+		return app.setupSecondaryVersionDB(homePath, primaryDir, keys, tkeys, memKeys, okeys, appOpts)
+	}
+
 	dataDir := filepath.Join(homePath, "data", "versiondb")
 	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
 		return nil, err
@@ -45,20 +59,96 @@ func (app *App) setupVersionDB(
 	sm.ABCIListeners = append(sm.ABCIListeners,
 		versiondb.NewStreamingService(versionDB),
 	)
+	if verifyInterval := cast.ToInt64(appOpts.Get(FlagVersionDBVerifyInterval)); verifyInterval > 0 {
+		// must be registered after the streaming service, so the version is already written.
+		sm.ABCIListeners = append(sm.ABCIListeners,
+			versiondb.NewVerifier(versionDB, app.CommitMultiStore(), keys, verifyInterval, app.Logger()),
+		)
+	}
 	app.SetStreamingManager(sm)
 
-	delegatedStoreKeys := make(map[storetypes.StoreKey]struct{})
-	for _, k := range tkeys {
-		delegatedStoreKeys[k] = struct{}{}
+	if cast.ToBool(appOpts.Get(FlagVersionDBSnapshotExtension)) {
+		if manager := app.SnapshotManager(); manager != nil {
+			storeNames := make([]string, 0, len(keys))
+			for name := range keys {
+				storeNames = append(storeNames, name)
+			}
+			sort.Strings(storeNames)
+
+			history := cast.ToInt64(appOpts.Get(FlagVersionDBSnapshotHistory))
+			if err := manager.RegisterExtensions(tsrocksdb.NewExtensionSnapshotter(versionDB, storeNames, history)); err != nil {
+				return nil, err
+			}
+			app.versionDBSnapshotter = true
+		}
 	}
-	for _, k := range memKeys {
-		delegatedStoreKeys[k] = struct{}{}
+
+	verDB := versiondb.NewMultiStore(app.CommitMultiStore(), versionDB, keys, delegatedStoreKeys(tkeys, memKeys, okeys))
+	app.SetQueryMultiStore(verDB)
+	return verDB, nil
+}
+
+// setupSecondaryVersionDB opens the versiondb of another node process as a rocksdb secondary instance, to serve the
+// queries in a separate read-only process, it don't write to versiondb, but catch up with the primary periodically.
+func (app *App) setupSecondaryVersionDB(
+	homePath string,
+	primaryDir string,
+	keys map[string]*storetypes.KVStoreKey,
+	tkeys map[string]*storetypes.TransientStoreKey,
+	memKeys map[string]*storetypes.MemoryStoreKey,
+	okeys map[string]*storetypes.ObjectStoreKey,
+	appOpts servertypes.AppOptions,
+) (storetypes.RootMultiStore, error) {
+	secondaryDir := filepath.Join(homePath, "data", "versiondb-secondary")
+	if err := os.MkdirAll(secondaryDir, os.ModePerm); err != nil {
+		return nil, err
 	}
-	for _, k := range okeys {
-		delegatedStoreKeys[k] = struct{}{}
+
+	versionDB, err := tsrocksdb.NewSecondaryStore(primaryDir, secondaryDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// see: https://github.com/crypto-org-chain/cronos/issues/1683
+	versionDB.SetSkipVersionZero(true)
+
+	interval := cast.ToDuration(appOpts.Get(FlagVersionDBCatchUpInterval))
+	if interval <= 0 {
+		interval = defaultCatchUpInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		versionDB.CatchUpWithPrimary(ctx, interval, app.Logger())
+	}()
+	app.closeSecondaryVersionDB = func() {
+		// stop the catch up loop before closing the db
+		cancel()
+		<-done
+		versionDB.Close()
 	}
 
-	verDB := versiondb.NewMultiStore(app.CommitMultiStore(), versionDB, keys, delegatedStoreKeys)
+	verDB := versiondb.NewMultiStore(app.CommitMultiStore(), versionDB, keys, delegatedStoreKeys(tkeys, memKeys, okeys))
 	app.SetQueryMultiStore(verDB)
 	return verDB, nil
 }
+
+// delegatedStoreKeys returns the transient/memory/object store keys, which are not stored in versiondb.
+func delegatedStoreKeys(
+	tkeys map[string]*storetypes.TransientStoreKey,
+	memKeys map[string]*storetypes.MemoryStoreKey,
+	okeys map[string]*storetypes.ObjectStoreKey,
+) map[storetypes.StoreKey]struct{} {
+	keys := make(map[storetypes.StoreKey]struct{})
+	for _, k := range tkeys {
+		keys[k] = struct{}{}
+	}
+	for _, k := range memKeys {
+		keys[k] = struct{}{}
+	}
+	for _, k := range okeys {
+		keys[k] = struct{}{}
+	}
+	return keys
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	storetypes "cosmossdk.io/store/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
 func (app *App) setupVersionDB(
@@ -15,6 +16,7 @@ func (app *App) setupVersionDB(
 	tkeys map[string]*storetypes.TransientStoreKey,
 	memKeys map[string]*storetypes.MemoryStoreKey,
 	okeys map[string]*storetypes.ObjectStoreKey,
+	appOpts servertypes.AppOptions,
 ) (storetypes.RootMultiStore, error) {
 	return nil, errors.New("versiondb is not supported in this binary")
 }
@@ -0,0 +1,62 @@
+//go:build rocksdb
+// +build rocksdb
+
+package app_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/crypto-org-chain/cronos/v2/app"
+	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSecondaryVersionDBAhead opens a read replica whose versiondb is ahead of the local store,
+// the local store is loaded at its own version, and the queries follow the versiondb.
+func TestSecondaryVersionDBAhead(t *testing.T) {
+	primaryDir := filepath.Join(t.TempDir(), "versiondb")
+	primary, err := tsrocksdb.NewStore(primaryDir)
+	require.NoError(t, err)
+	key := []byte("hello")
+	for version := int64(1); version <= 10; version++ {
+		require.NoError(t, primary.PutAtVersion(version, []*storetypes.StoreKVPair{
+			{StoreKey: banktypes.StoreKey, Key: key, Value: []byte{byte(version)}},
+		}))
+	}
+	require.NoError(t, primary.Flush())
+	defer primary.Close()
+
+	replica := app.New(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+		app.AppOptionsMap{
+			flags.FlagHome:                   t.TempDir(),
+			"versiondb.enable":               true,
+			app.FlagVersionDBPrimaryDir:      primaryDir,
+			app.FlagVersionDBCatchUpInterval: time.Hour,
+		},
+		baseapp.SetChainID(app.TestAppChainID),
+	)
+	defer replica.Close()
+
+	require.Equal(t, int64(0), replica.LastBlockHeight())
+
+	ctx, err := replica.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), ctx.BlockHeight())
+	require.Equal(t, []byte{10}, ctx.KVStore(replica.GetKey(banktypes.StoreKey)).Get(key))
+
+	ctx, err = replica.CreateQueryContext(5, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte{5}, ctx.KVStore(replica.GetKey(banktypes.StoreKey)).Get(key))
+
+	_, err = replica.CreateQueryContext(11, false)
+	require.Error(t, err)
+}
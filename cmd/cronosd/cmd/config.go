@@ -1,13 +1,36 @@
 package cmd
 
+import "time"
+
 type VersionDBConfig struct {
 	// Enable defines if the versiondb should be enabled.
 	Enable bool `mapstructure:"enable"`
+	// VerifyInterval defines the block interval to verify versiondb against the committed state in background,
+	// 0 means disabled, each check loads the whole committed state at the previous version, which is expensive on
+	// memiavl, so it's opt-in.
+	VerifyInterval int64 `mapstructure:"verify-interval"`
+	// SnapshotExtension defines if the versiondb contents are included in the state-sync snapshots,
+	// the nodes restoring the snapshots must enable versiondb too.
+	SnapshotExtension bool `mapstructure:"snapshot-extension"`
+	// SnapshotHistory defines the number of versions of history before the snapshot height to include in the
+	// state-sync snapshots, 0 means only the state at snapshot height.
+	SnapshotHistory int64 `mapstructure:"snapshot-history"`
+	// DiscardSnapshotExtension defines if the versiondb contents in the state-sync snapshots are discarded when
+	// restored, for the nodes without the snapshot extension to restore such snapshots, the snapshots they create
+	// carry an empty versiondb extension while it's enabled, which the nodes without it can't restore.
+	DiscardSnapshotExtension bool `mapstructure:"discard-snapshot-extension"`
+	// PrimaryDir defines the versiondb directory of another node process, if set, the versiondb is opened as a
+	// read-only secondary instance of it, to serve the queries in a separate process, like `start --grpc-only`.
+	PrimaryDir string `mapstructure:"primary-dir"`
+	// CatchUpInterval defines the interval for the secondary instance to catch up with the primary one.
+	CatchUpInterval time.Duration `mapstructure:"catch-up-interval"`
 }
 
 func DefaultVersionDBConfig() VersionDBConfig {
 	return VersionDBConfig{
-		Enable: false,
+		Enable:          false,
+		VerifyInterval:  0,
+		CatchUpInterval: time.Second,
 	}
 }
 
@@ -15,4 +38,29 @@ var DefaultVersionDBTemplate = `
 [versiondb]
 # Enable defines if the versiondb should be enabled.
 enable = {{ .VersionDB.Enable }}
+
+# VerifyInterval defines the block interval to verify versiondb against the committed state in background,
+# 0 means disabled. Each check loads the whole committed state at the previous version and iterates all the stores,
+# on memiavl it's a full historical snapshot loaded per check, set a large interval if enabled.
+verify-interval = {{ .VersionDB.VerifyInterval }}
+
+# SnapshotExtension defines if the versiondb contents are included in the state-sync snapshots,
+# the nodes restoring the snapshots must enable versiondb too.
+snapshot-extension = {{ .VersionDB.SnapshotExtension }}
+
+# SnapshotHistory defines the number of versions of history before the snapshot height to include in the
+# state-sync snapshots, 0 means only the state at snapshot height.
+snapshot-history = {{ .VersionDB.SnapshotHistory }}
+
+# DiscardSnapshotExtension defines if the versiondb contents in the state-sync snapshots are discarded when
+# restored, for the nodes without the snapshot extension to restore such snapshots, the snapshots they create
+# carry an empty versiondb extension while it's enabled, which the nodes without it can't restore.
+discard-snapshot-extension = {{ .VersionDB.DiscardSnapshotExtension }}
+
+# PrimaryDir defines the versiondb directory of another node process, if set, the versiondb is opened as a
+# read-only secondary instance of it, to serve the queries in a separate process, like "start --grpc-only".
+primary-dir = "{{ .VersionDB.PrimaryDir }}"
+
+# CatchUpInterval defines the interval for the secondary instance to catch up with the primary one.
+catch-up-interval = "{{ .VersionDB.CatchUpInterval }}"
 `
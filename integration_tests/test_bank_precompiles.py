@@ -1,8 +1,11 @@
+import json
+
 import pytest
 import web3
 
 from .utils import (
     ADDRS,
+    CONTRACT_ABIS,
     CONTRACTS,
     KEYS,
     deploy_contract,
@@ -11,7 +14,7 @@ from .utils import (
     send_transaction,
 )
 
-pytest.skip("skipping bank precompile tests", allow_module_level=True)
+BANK_CONTRACT = "0x0000000000000000000000000000000000000064"
 
 
 def get_balance(cli, addr, denom):
@@ -84,3 +87,37 @@ def test_call(cronos):
     amt4 = 20
     with pytest.raises(web3.exceptions.ContractLogicError):
         contract.functions.nativeTransfer(recipient, amt4).build_transaction(data)
+
+
+def test_allowance(cronos):
+    w3 = cronos.w3
+    cli = cronos.cosmos_cli()
+    owner = ADDRS["signer1"]
+    spender = ADDRS["signer2"]
+    recipient = ADDRS["community"]
+    contract = deploy_contract(w3, CONTRACTS["TestBank"], (), KEYS["signer1"])
+    denom = "evm/" + contract.address
+    tx = contract.functions.moveToNative(100).build_transaction({"from": owner})
+    assert send_transaction(w3, tx, KEYS["signer1"]).status == 1
+
+    abi = json.loads(CONTRACT_ABIS["IBankModule"].read_text())
+    bank = w3.eth.contract(address=BANK_CONTRACT, abi=abi)
+    tx = bank.functions.approve(contract.address, spender, 30).build_transaction(
+        {"from": owner}
+    )
+    assert send_transaction(w3, tx, KEYS["signer1"]).status == 1
+    assert bank.caller.allowance(contract.address, owner, spender) == 30
+
+    # transfer more than the allowance
+    with pytest.raises(web3.exceptions.ContractLogicError):
+        bank.functions.transferFrom(
+            contract.address, owner, recipient, 31
+        ).build_transaction({"from": spender})
+
+    balance = get_balance(cli, recipient, denom)
+    tx = bank.functions.transferFrom(
+        contract.address, owner, recipient, 20
+    ).build_transaction({"from": spender})
+    assert send_transaction(w3, tx, KEYS["signer2"]).status == 1
+    assert get_balance(cli, recipient, denom) == balance + 20
+    assert bank.caller.allowance(contract.address, owner, spender) == 10
@@ -6,7 +6,17 @@ from hexbytes import HexBytes
 from pystarport import ports
 
 from .network import Cronos
-from .utils import ADDRS, bech32_to_eth, wait_for_new_blocks, wait_for_port
+from .utils import (
+    ADDRS,
+    CONTRACT_ABIS,
+    KEYS,
+    bech32_to_eth,
+    send_transaction,
+    wait_for_new_blocks,
+    wait_for_port,
+)
+
+E2EE_CONTRACT = "0x000000000000000000000000000000000000006B"
 
 
 def test_register(cronos: Cronos):
@@ -18,6 +28,28 @@ def test_register(cronos: Cronos):
     assert not cli.query_e2ee_key(cli.address("validator"))
 
 
+def test_register_precompile(cronos: Cronos):
+    cli = cronos.cosmos_cli()
+    w3 = cronos.w3
+    abi = json.loads(CONTRACT_ABIS["IE2EEModule"].read_text())
+    contract = w3.eth.contract(address=E2EE_CONTRACT, abi=abi)
+    addr = ADDRS["signer1"]
+    pubkey = cli.e2ee_keygen(keyring_name="key1")
+
+    tx = contract.functions.registerEncryptionKey(
+        pubkey + "malformed"
+    ).build_transaction({"from": addr})
+    assert send_transaction(w3, tx, KEYS["signer1"]).status == 0
+
+    tx = contract.functions.registerEncryptionKey(pubkey).build_transaction(
+        {"from": addr}
+    )
+    assert send_transaction(w3, tx, KEYS["signer1"]).status == 1
+    assert cli.query_e2ee_key(cli.address("signer1")) == pubkey
+    assert contract.functions.key(addr).call() == pubkey
+    assert contract.functions.keys([addr, ADDRS["signer2"]]).call() == [pubkey, ""]
+
+
 def gen_validator_identity(cronos: Cronos):
     for i in range(len(cronos.config["validators"])):
         cli = cronos.cosmos_cli(i)
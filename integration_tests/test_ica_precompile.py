@@ -34,6 +34,8 @@ pytestmark = pytest.mark.ica
 CONTRACT = "0x0000000000000000000000000000000000000066"
 connid = "connection-0"
 no_timeout = 300000000000
+# channel state OPEN
+STATE_OPEN = 3
 denom = "basecro"
 validator = "validator"
 amt = 1000
@@ -177,6 +179,8 @@ def test_call(ibc, order):
         order,
         signer=signer,
     )
+    res = contract.functions.queryActiveChannel(connid, ADDRS[signer], "").call()
+    assert res == [channel_id, STATE_OPEN], res
     balance = funds_ica(cli_host, ica_address, signer=signer)
     expected_seq = 1
     _, diff = submit_msgs(
@@ -210,6 +214,33 @@ def test_call(ibc, order):
     assert cli_host.balance(ica_address, denom=denom) == balance
 
 
+def test_call_with_owner_id(ibc):
+    signer = "signer2"
+    owner_id = "1"
+    cli_controller = ibc.cronos.cosmos_cli()
+    w3 = ibc.cronos.w3
+    contract_info = json.loads(CONTRACT_ABIS["IICAModule"].read_text())
+    contract = w3.eth.contract(address=CONTRACT, abi=contract_info)
+    data = {"from": ADDRS[signer]}
+    channel_id = get_next_channel(cli_controller, connid)
+    tx = contract.functions.registerAccountWithOwnerID(
+        connid, owner_id, "", Ordering.ORDERED.value
+    ).build_transaction(data)
+    receipt = send_transaction(w3, tx, KEYS[signer])
+    assert receipt.status == 1
+    wait_for_check_channel_ready(cli_controller, connid, channel_id)
+    owner = f"{eth_to_bech32(ADDRS[signer])}.{owner_id}"
+    ica_address = cli_controller.ica_query_account(connid, owner)["address"]
+    res = contract.functions.queryAccountWithOwnerID(
+        connid, ADDRS[signer], owner_id
+    ).call()
+    assert ica_address == res, res
+    # the account without owner id is a different one
+    assert contract.functions.queryAccount(connid, ADDRS[signer]).call() != res
+    res = contract.functions.queryActiveChannel(connid, ADDRS[signer], owner_id).call()
+    assert res == [channel_id, STATE_OPEN], res
+
+
 def wait_for_packet_log(start, event, channel_id, seq, status):
     print("wait for log arrive", seq, status)
     expected = AttributeDict(
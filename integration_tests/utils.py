@@ -90,6 +90,8 @@ CONTRACTS = {
 CONTRACT_ABIS = {
     "IRelayerModule": Path(__file__).parent.parent / "build/IRelayerModule.abi",
     "IICAModule": Path(__file__).parent.parent / "build/IICAModule.abi",
+    "IBankModule": Path(__file__).parent.parent / "build/IBankModule.abi",
+    "IE2EEModule": Path(__file__).parent.parent / "build/IE2EEModule.abi",
 }
 
 
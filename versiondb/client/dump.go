@@ -4,8 +4,12 @@ import (
 	"bufio"
 	"compress/zlib"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"math"
 	"os"
@@ -21,11 +25,13 @@ import (
 	"github.com/golang/snappy"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
+	"github.com/tidwall/wal"
 
 	"cosmossdk.io/store/wrapper"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 
+	"github.com/crypto-org-chain/cronos/memiavl"
 	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
 )
 
@@ -34,7 +40,7 @@ const DefaultChunkSize = 1000000
 func DumpChangeSetCmd(opts Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "dump outDir",
-		Short: "Extract changesets from iavl versions, and save to plain file format",
+		Short: "Extract changesets from iavl versions or memiavl wal, and save to plain file format, the finished chunks are tracked in a manifest file so the job can be resumed",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
@@ -42,13 +48,6 @@ func DumpChangeSetCmd(opts Options) *cobra.Command {
 				return err
 			}
 
-			db, err := opts.OpenReadOnlyDB(ctx.Viper.GetString(flags.FlagHome), server.GetAppDBBackend(ctx.Viper))
-			if err != nil {
-				return err
-			}
-
-			cacheSize := cast.ToInt(ctx.Viper.Get(server.FlagIAVLCacheSize))
-
 			startVersion, err := cmd.Flags().GetInt64(flagStartVersion)
 			if err != nil {
 				return err
@@ -81,6 +80,34 @@ func DumpChangeSetCmd(opts Options) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			walDir, err := cmd.Flags().GetString(flagMemIAVLWAL)
+			if err != nil {
+				return err
+			}
+			initialVersion, err := cmd.Flags().GetUint32(flagInitialVersion)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := loadManifest(outDir)
+			if err != nil {
+				return err
+			}
+
+			// create fixed size task pool with big enough buffer.
+			pool := pond.New(concurrency, 1024)
+			defer pool.StopAndWait()
+
+			if len(walDir) > 0 {
+				return dumpFromWAL(pool, manifest, walDir, initialVersion, outDir, stores, Range{Start: startVersion, End: endVersion}, chunkSize, zlibLevel)
+			}
+
+			db, err := opts.OpenReadOnlyDB(ctx.Viper.GetString(flags.FlagHome), server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+
+			cacheSize := cast.ToInt(ctx.Viper.Get(server.FlagIAVLCacheSize))
 
 			if endVersion == 0 {
 				// use the latest version of the first store for all stores
@@ -94,14 +121,10 @@ func DumpChangeSetCmd(opts Options) *cobra.Command {
 				fmt.Println("end version not specified, default to latest version + 1,", endVersion)
 			}
 
-			// create fixed size task pool with big enough buffer.
-			pool := pond.New(concurrency, 1024)
-			defer pool.StopAndWait()
-
-			// we handle multiple stores sequentially, because different stores don't share much in db, handle concurrently reduces cache efficiency.
+			// plan the chunks of all stores first, skip the finished ones, so we can report the overall progress.
+			var total int64
+			plans := make(map[string][]chunk, len(stores))
 			for _, store := range stores {
-				fmt.Println("begin store", store, time.Now().Format(time.RFC3339))
-
 				// find the first version in the db, reading raw db because no public api for it.
 				prefix := []byte(fmt.Sprintf(tsrocksdb.StorePrefixTpl, store))
 				storeStartVersion, err := getFirstVersion(dbm.NewPrefixDB(db, prefix), iavlVersion)
@@ -110,13 +133,39 @@ func DumpChangeSetCmd(opts Options) *cobra.Command {
 				}
 				if storeStartVersion == 0 {
 					// store not exists
-					fmt.Println("skip empty store")
+					fmt.Println("skip empty store", store)
 					continue
 				}
 				if startVersion > storeStartVersion {
 					storeStartVersion = startVersion
 				}
 
+				for _, r := range splitChunks(Range{Start: storeStartVersion, End: endVersion}, chunkSize) {
+					c := chunk{store: store, beginVersion: r.Start, endVersion: r.End}
+					done, err := manifest.isDone(outDir, store, c.fileName(zlibLevel), r)
+					if err != nil {
+						return err
+					}
+					if done {
+						fmt.Println("skip finished chunk", c.fileName(zlibLevel))
+						continue
+					}
+					plans[store] = append(plans[store], c)
+					total += r.End - r.Start
+				}
+			}
+			progress := newProgress(total)
+
+			// we handle multiple stores sequentially, because different stores don't share much in db, handle concurrently reduces cache efficiency.
+			for _, store := range stores {
+				chunks := plans[store]
+				if len(chunks) == 0 {
+					continue
+				}
+
+				fmt.Println("begin store", store, time.Now().Format(time.RFC3339))
+				prefix := []byte(fmt.Sprintf(tsrocksdb.StorePrefixTpl, store))
+
 				// share the iavl tree between tasks to reuse the node cache
 				iavlTreePool := sync.Pool{
 					New: func() any {
@@ -125,18 +174,11 @@ func DumpChangeSetCmd(opts Options) *cobra.Command {
 					},
 				}
 
-				// first split work load into chunks
-				var chunks []chunk
-				for i := storeStartVersion; i < endVersion; i += int64(chunkSize) {
-					end := i + int64(chunkSize)
-					if end > endVersion {
-						end = endVersion
-					}
-
-					var taskFiles []string
+				for i := range chunks {
+					c := &chunks[i]
 					group, _ := pool.GroupContext(context.Background())
 					// then split each chunk according to number of workers, the results will be concatenated into a single chunk file
-					for _, workRange := range splitWorkLoad(concurrency, Range{Start: i, End: end}) {
+					for _, workRange := range splitWorkLoad(concurrency, Range{Start: c.beginVersion, End: c.endVersion}) {
 						// https://github.com/golang/go/wiki/CommonMistakes#using-goroutines-on-loop-iterator-variables
 						workRange := workRange
 						taskFile := filepath.Join(outDir, fmt.Sprintf("tmp-%s-%d.snappy", store, workRange.Start))
@@ -146,19 +188,27 @@ func DumpChangeSetCmd(opts Options) *cobra.Command {
 							return dumpRangeBlocks(taskFile, tree, workRange)
 						})
 
-						taskFiles = append(taskFiles, taskFile)
+						c.taskFiles = append(c.taskFiles, taskFile)
 					}
-
-					chunks = append(chunks, chunk{
-						store: store, beginVersion: i, taskFiles: taskFiles, taskGroup: group,
-					})
+					c.taskGroup = group
 				}
 
 				// for each chunk, wait for related tasks to finish, and concatenate the result files in order
-				for _, chunk := range chunks {
-					if err := chunk.collect(outDir, zlibLevel); err != nil {
+				for _, c := range chunks {
+					checksum, err := c.collect(outDir, zlibLevel)
+					if err != nil {
 						return err
 					}
+					if err := manifest.add(ManifestEntry{
+						Store:    store,
+						Start:    c.beginVersion,
+						End:      c.endVersion,
+						File:     c.fileName(zlibLevel),
+						Checksum: checksum,
+					}); err != nil {
+						return err
+					}
+					progress.report(store, Range{Start: c.beginVersion, End: c.endVersion})
 				}
 			}
 
@@ -173,9 +223,221 @@ func DumpChangeSetCmd(opts Options) *cobra.Command {
 	cmd.Flags().Int(flagZlibLevel, 6, "level of zlib compression, 0: plain data, 1: fast, 9: best, default: 6, if not 0 the output file name will have .zz extension")
 	cmd.Flags().String(flagStores, "", "list of store names, default to the current store list in application")
 	cmd.Flags().Int(flagIAVLVersion, IAVLV1, "IAVL version, 0: v0, 1: v1")
+	cmd.Flags().String(flagMemIAVLWAL, "", "dump from the memiavl wal directory instead of the iavl application.db, e.g. data/memiavl.db/wal")
+	cmd.Flags().Uint32(flagInitialVersion, 0, "the initial version of the memiavl db, only used with --memiavl-wal")
 	return cmd
 }
 
+// openWALReadOnly opens the memiavl wal through a snapshot of its segment files, hard linked or copied into a temporary
+// directory, because opening the wal in place could modify it, like cleaning up the segments of an unfinished
+// truncation, the wal of a live node must not be touched. A corrupted tail fails the open instead of being repaired.
+func openWALReadOnly(walDir string) (*wal.Log, func(), error) {
+	tmpDir, err := os.MkdirTemp("", "memiavl-wal-")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() { _ = os.RemoveAll(tmpDir) }
+
+	entries, err := os.ReadDir(walDir)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		src, dst := filepath.Join(walDir, entry.Name()), filepath.Join(tmpDir, entry.Name())
+		if err := os.Link(src, dst); err != nil {
+			// fallback to copy, for example, the temporary directory is on another file system
+			if err := copyFile(src, dst); err != nil {
+				cleanup()
+				return nil, nil, err
+			}
+		}
+	}
+
+	l, err := wal.Open(tmpDir, &wal.Options{NoCopy: true})
+	if err != nil {
+		cleanup()
+		if errors.Is(err, wal.ErrCorrupt) {
+			return nil, nil, fmt.Errorf("wal %s is corrupted, not repaired by the dump command: %w", walDir, err)
+		}
+		return nil, nil, err
+	}
+	return l, func() {
+		l.Close()
+		cleanup()
+	}, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// dumpFromWAL extracts change sets from memiavl wal, each chunk is handled by a task, which writes the chunk files of
+// all stores in one pass of the wal entries.
+func dumpFromWAL(
+	pool *pond.WorkerPool,
+	manifest *manifest,
+	walDir string,
+	initialVersion uint32,
+	outDir string,
+	stores []string,
+	versionRange Range,
+	chunkSize, zlibLevel int,
+) error {
+	wal, closeWAL, err := openWALReadOnly(walDir)
+	if err != nil {
+		return err
+	}
+	defer closeWAL()
+
+	firstIndex, err := wal.FirstIndex()
+	if err != nil {
+		return err
+	}
+	lastIndex, err := wal.LastIndex()
+	if err != nil {
+		return err
+	}
+	if lastIndex == 0 {
+		return errors.New("empty wal")
+	}
+
+	firstVersion := walVersion(firstIndex, initialVersion)
+	if versionRange.Start < firstVersion {
+		versionRange.Start = firstVersion
+	}
+	if lastVersion := walVersion(lastIndex, initialVersion); versionRange.End == 0 || versionRange.End > lastVersion+1 {
+		versionRange.End = lastVersion + 1
+	}
+
+	type walChunk struct {
+		Range
+		stores []string
+	}
+	var (
+		chunks []walChunk
+		total  int64
+	)
+	for _, r := range splitChunks(versionRange, chunkSize) {
+		c := walChunk{Range: r}
+		for _, store := range stores {
+			file := chunk{store: store, beginVersion: r.Start}.fileName(zlibLevel)
+			done, err := manifest.isDone(outDir, store, file, r)
+			if err != nil {
+				return err
+			}
+			if done {
+				fmt.Println("skip finished chunk", file)
+				continue
+			}
+			c.stores = append(c.stores, store)
+		}
+		if len(c.stores) > 0 {
+			chunks = append(chunks, c)
+			total += r.End - r.Start
+		}
+	}
+	for _, store := range stores {
+		if err := os.MkdirAll(filepath.Join(outDir, store), os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	progress := newProgress(total)
+	group, _ := pool.GroupContext(context.Background())
+	for _, c := range chunks {
+		// https://github.com/golang/go/wiki/CommonMistakes#using-goroutines-on-loop-iterator-variables
+		c := c
+		group.Submit(func() error {
+			writers := make(map[string]*chunkWriter, len(c.stores))
+			defer func() {
+				for _, w := range writers {
+					w.fp.Close()
+				}
+			}()
+			for _, store := range c.stores {
+				w, err := newChunkWriter(filepath.Join(outDir, chunk{store: store, beginVersion: c.Start}.fileName(zlibLevel)), zlibLevel)
+				if err != nil {
+					return err
+				}
+				writers[store] = w
+			}
+
+			for version := c.Start; version < c.End; version++ {
+				bz, err := wal.Read(walIndex(version, initialVersion))
+				if err != nil {
+					return fmt.Errorf("read wal log failed, %w", err)
+				}
+				var entry memiavl.WALEntry
+				if err := entry.Unmarshal(bz); err != nil {
+					return fmt.Errorf("unmarshal wal log failed, %w", err)
+				}
+				for _, cs := range entry.Changesets {
+					w, ok := writers[cs.Name]
+					if !ok {
+						continue
+					}
+					if err := WriteChangeSet(w, version, convertMemIAVLChangeSet(cs.Changeset)); err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, store := range c.stores {
+				checksum, err := writers[store].Close()
+				if err != nil {
+					return err
+				}
+				if err := manifest.add(ManifestEntry{
+					Store:    store,
+					Start:    c.Start,
+					End:      c.End,
+					File:     chunk{store: store, beginVersion: c.Start}.fileName(zlibLevel),
+					Checksum: checksum,
+				}); err != nil {
+					return err
+				}
+			}
+			progress.report("wal", c.Range)
+			return nil
+		})
+	}
+
+	return group.Wait()
+}
+
+// walIndex converts version to wal index based on initial version, same as memiavl.
+func walIndex(v int64, initialVersion uint32) uint64 {
+	if initialVersion > 1 {
+		return uint64(v) - uint64(initialVersion) + 1
+	}
+	return uint64(v)
+}
+
+// walVersion converts wal index to version, reverse of walIndex.
+func walVersion(index uint64, initialVersion uint32) int64 {
+	if initialVersion > 1 {
+		return int64(index) + int64(initialVersion) - 1
+	}
+	return int64(index)
+}
+
 // Range represents a range `[start, end)`
 type Range struct {
 	Start, End int64
@@ -194,6 +456,19 @@ func splitWorkLoad(workers int, full Range) []Range {
 	return chunks
 }
 
+// splitChunks split the range into block chunks aligned with the start version.
+func splitChunks(full Range, chunkSize int) []Range {
+	var chunks []Range
+	for i := full.Start; i < full.End; i += int64(chunkSize) {
+		end := i + int64(chunkSize)
+		if end > full.End {
+			end = full.End
+		}
+		chunks = append(chunks, Range{Start: i, End: end})
+	}
+	return chunks
+}
+
 func dumpRangeBlocks(outputFile string, tree *iavl.ImmutableTree, blockRange Range) (returnErr error) {
 	fp, err := createFile(outputFile)
 	if err != nil {
@@ -220,66 +495,96 @@ func dumpRangeBlocks(outputFile string, tree *iavl.ImmutableTree, blockRange Ran
 type chunk struct {
 	store        string
 	beginVersion int64
+	endVersion   int64
 	taskFiles    []string
 	taskGroup    *pond.TaskGroupWithContext
 }
 
-// collect wait for the tasks to complete and concatenate the files into a single output file.
-func (c *chunk) collect(outDir string, zlibLevel int) (returnErr error) {
-	storeDir := filepath.Join(outDir, c.store)
-	if err := os.MkdirAll(storeDir, os.ModePerm); err != nil {
-		return err
+// fileName returns the chunk file path relative to the output directory.
+func (c chunk) fileName(zlibLevel int) string {
+	name := filepath.Join(c.store, fmt.Sprintf("block-%d", c.beginVersion))
+	if zlibLevel > 0 {
+		name += ZlibFileSuffix
 	}
+	return name
+}
 
-	output := filepath.Join(storeDir, fmt.Sprintf("block-%d", c.beginVersion))
-	if zlibLevel > 0 {
-		output += ZlibFileSuffix
+// collect wait for the tasks to complete and concatenate the files into a single output file,
+// returns the checksum of the output file.
+func (c *chunk) collect(outDir string, zlibLevel int) (string, error) {
+	if err := os.MkdirAll(filepath.Join(outDir, c.store), os.ModePerm); err != nil {
+		return "", err
 	}
 
 	if err := c.taskGroup.Wait(); err != nil {
-		return err
+		return "", err
 	}
 
-	fp, err := createFile(output)
+	writer, err := newChunkWriter(filepath.Join(outDir, c.fileName(zlibLevel)), zlibLevel)
 	if err != nil {
-		return err
-	}
-	defer func() {
-		if err := fp.Close(); returnErr == nil {
-			returnErr = err
-		}
-	}()
-
-	bufWriter := bufio.NewWriter(fp)
-	writer := io.Writer(bufWriter)
-
-	var zwriter *zlib.Writer
-	if zlibLevel > 0 {
-		var err error
-		zwriter, err = zlib.NewWriterLevel(bufWriter, zlibLevel)
-		if err != nil {
-			return err
-		}
-
-		writer = zwriter
+		return "", err
 	}
+	defer writer.fp.Close()
 
 	for _, taskFile := range c.taskFiles {
 		if err := copyTmpFile(writer, taskFile); err != nil {
-			return err
+			return "", err
 		}
 		if err := os.Remove(taskFile); err != nil {
-			return err
+			return "", err
 		}
 	}
 
-	if zwriter != nil {
-		if err := zwriter.Close(); err != nil {
-			return err
+	return writer.Close()
+}
+
+// chunkWriter writes the chunk file with optional zlib compression, and computes the checksum of the file.
+type chunkWriter struct {
+	fp        *os.File
+	bufWriter *bufio.Writer
+	zwriter   *zlib.Writer
+	writer    io.Writer
+	hasher    hash.Hash
+}
+
+func newChunkWriter(output string, zlibLevel int) (*chunkWriter, error) {
+	fp, err := createFile(output)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &chunkWriter{fp: fp, hasher: sha256.New()}
+	w.bufWriter = bufio.NewWriter(io.MultiWriter(fp, w.hasher))
+	w.writer = w.bufWriter
+	if zlibLevel > 0 {
+		w.zwriter, err = zlib.NewWriterLevel(w.bufWriter, zlibLevel)
+		if err != nil {
+			fp.Close()
+			return nil, err
 		}
+		w.writer = w.zwriter
 	}
+	return w, nil
+}
 
-	return bufWriter.Flush()
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	return w.writer.Write(p)
+}
+
+// Close flushes and closes the file, returns the hex encoded sha256 checksum of the file.
+func (w *chunkWriter) Close() (string, error) {
+	if w.zwriter != nil {
+		if err := w.zwriter.Close(); err != nil {
+			return "", err
+		}
+	}
+	if err := w.bufWriter.Flush(); err != nil {
+		return "", err
+	}
+	if err := w.fp.Close(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(w.hasher.Sum(nil)), nil
 }
 
 // copyTmpFile append the snappy compressed temporary file to writer
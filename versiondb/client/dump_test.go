@@ -0,0 +1,105 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alitto/pond"
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crypto-org-chain/cronos/memiavl"
+)
+
+func TestDumpFromWAL(t *testing.T) {
+	dbDir := t.TempDir()
+	db, err := memiavl.Load(dbDir, memiavl.Options{CreateIfMissing: true, InitialStores: []string{"test"}})
+	require.NoError(t, err)
+	for _, cs := range ChangeSets {
+		require.NoError(t, db.ApplyChangeSet("test", convertChangeSet(cs)))
+		_, err := db.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.Close())
+
+	outDir := t.TempDir()
+	dump := func() {
+		pool := pond.New(4, 1024)
+		defer pool.StopAndWait()
+		m, err := loadManifest(outDir)
+		require.NoError(t, err)
+		require.NoError(t, dumpFromWAL(pool, m, filepath.Join(dbDir, "wal"), 0, outDir, []string{"test"}, Range{}, 4, 6))
+	}
+	dump()
+
+	m, err := loadManifest(outDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(m.Entries))
+
+	var changeSets []*iavl.ChangeSet
+	files, err := scanChangeSetFiles(outDir, "test")
+	require.NoError(t, err)
+	for _, file := range files {
+		require.NoError(t, withChangeSetFile(file.FileName, func(reader Reader) error {
+			_, err := IterateChangeSets(reader, func(version int64, changeSet *iavl.ChangeSet) (bool, error) {
+				require.Equal(t, int64(len(changeSets)+1), version)
+				changeSets = append(changeSets, changeSet)
+				return true, nil
+			})
+			return err
+		}))
+	}
+	require.Equal(t, len(ChangeSets), len(changeSets))
+	for i, cs := range ChangeSets {
+		require.Equal(t, len(cs.Pairs), len(changeSets[i].Pairs))
+	}
+
+	// rerun skips the finished chunks
+	for _, entry := range m.Entries {
+		done, err := m.isDone(outDir, entry.Store, entry.File, Range{Start: entry.Start, End: entry.End})
+		require.NoError(t, err)
+		require.True(t, done)
+	}
+	dump()
+	m2, err := loadManifest(outDir)
+	require.NoError(t, err)
+	require.Equal(t, m.Entries, m2.Entries)
+}
+
+func TestDumpFromCorruptedWAL(t *testing.T) {
+	dbDir := t.TempDir()
+	db, err := memiavl.Load(dbDir, memiavl.Options{CreateIfMissing: true, InitialStores: []string{"test"}})
+	require.NoError(t, err)
+	for _, cs := range ChangeSets {
+		require.NoError(t, db.ApplyChangeSet("test", convertChangeSet(cs)))
+		_, err := db.Commit()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.Close())
+
+	// append an incomplete entry to the last segment
+	walDir := filepath.Join(dbDir, "wal")
+	entries, err := os.ReadDir(walDir)
+	require.NoError(t, err)
+	segment := filepath.Join(walDir, entries[len(entries)-1].Name())
+	fp, err := os.OpenFile(segment, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = fp.Write([]byte{0xff, 0xff, 0xff})
+	require.NoError(t, err)
+	require.NoError(t, fp.Close())
+	before, err := os.ReadFile(segment)
+	require.NoError(t, err)
+
+	outDir := t.TempDir()
+	pool := pond.New(4, 1024)
+	defer pool.StopAndWait()
+	m, err := loadManifest(outDir)
+	require.NoError(t, err)
+	require.Error(t, dumpFromWAL(pool, m, walDir, 0, outDir, []string{"test"}, Range{}, 4, 6))
+
+	// the wal is not repaired
+	after, err := os.ReadFile(segment)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+}
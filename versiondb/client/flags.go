@@ -27,4 +27,5 @@ const (
 	flagInitialVersion   = "initial-version"
 	flagSDK64Compact     = "sdk64-compact"
 	flagIAVLVersion      = "iavl-version"
+	flagMemIAVLWAL       = "memiavl-wal"
 )
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const ManifestFileName = "manifest.json"
+
+// ManifestEntry records a finished change set chunk file.
+type ManifestEntry struct {
+	Store string `json:"store"`
+	Start int64  `json:"start"`
+	End   int64  `json:"end"`
+	// File is the path of the chunk file relative to the output directory.
+	File string `json:"file"`
+	// Checksum is the hex encoded sha256 of the chunk file.
+	Checksum string `json:"checksum"`
+}
+
+// manifest tracks the finished chunks in the output directory, so the dump can resume after interrupted.
+type manifest struct {
+	mtx     sync.Mutex
+	path    string
+	Entries []ManifestEntry `json:"entries"`
+}
+
+// loadManifest loads the manifest file in the output directory, returns an empty one if not exists.
+func loadManifest(outDir string) (*manifest, error) {
+	m := &manifest{path: filepath.Join(outDir, ManifestFileName)}
+	bz, err := os.ReadFile(m.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, m); err != nil {
+		return nil, fmt.Errorf("invalid manifest file %s: %w", m.path, err)
+	}
+	return m, nil
+}
+
+// isDone checks if the chunk is finished, and the chunk file is not corrupted.
+func (m *manifest) isDone(outDir, store, file string, r Range) (bool, error) {
+	m.mtx.Lock()
+	var entry *ManifestEntry
+	for i := range m.Entries {
+		e := &m.Entries[i]
+		if e.Store == store && e.Start == r.Start && e.End == r.End && e.File == file {
+			entry = e
+			break
+		}
+	}
+	m.mtx.Unlock()
+
+	if entry == nil {
+		return false, nil
+	}
+
+	checksum, err := fileChecksum(filepath.Join(outDir, file))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return checksum == entry.Checksum, nil
+}
+
+// add records the finished chunk and persist the manifest file atomically.
+func (m *manifest) add(entry ManifestEntry) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	entries := m.Entries[:0]
+	for _, e := range m.Entries {
+		if e.Store != entry.Store || e.File != entry.File {
+			entries = append(entries, e)
+		}
+	}
+	m.Entries = append(entries, entry)
+
+	bz, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := m.path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, m.path)
+}
+
+func fileChecksum(name string) (string, error) {
+	fp, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer fp.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, fp); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// progress reports the finished versions and the estimated remaining time.
+type progress struct {
+	mtx       sync.Mutex
+	total     int64
+	done      int64
+	startTime time.Time
+}
+
+func newProgress(total int64) *progress {
+	return &progress{total: total, startTime: time.Now()}
+}
+
+func (p *progress) report(store string, r Range) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.done += r.End - r.Start
+	elapsed := time.Since(p.startTime)
+	var eta time.Duration
+	if p.done > 0 {
+		eta = time.Duration(float64(elapsed) * float64(p.total-p.done) / float64(p.done))
+	}
+	fmt.Printf(
+		"finished %s [%d, %d), progress: %d/%d (%.2f%%), elapsed: %s, eta: %s\n",
+		store, r.Start, r.End, p.done, p.total, float64(p.done)*100/float64(max(p.total, 1)),
+		elapsed.Round(time.Second), eta.Round(time.Second),
+	)
+}
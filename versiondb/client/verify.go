@@ -313,3 +313,17 @@ func convertChangeSet(cs *iavl.ChangeSet) memiavl.ChangeSet {
 		Pairs: pairs,
 	}
 }
+
+func convertMemIAVLChangeSet(cs memiavl.ChangeSet) *iavl.ChangeSet {
+	pairs := make([]*iavl.KVPair, len(cs.Pairs))
+	for i, pair := range cs.Pairs {
+		pairs[i] = &iavl.KVPair{
+			Delete: pair.Delete,
+			Key:    pair.Key,
+			Value:  pair.Value,
+		}
+	}
+	return &iavl.ChangeSet{
+		Pairs: pairs,
+	}
+}
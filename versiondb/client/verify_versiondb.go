@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/alitto/pond"
+	"github.com/linxGnu/grocksdb"
+	"github.com/spf13/cobra"
+
+	"github.com/crypto-org-chain/cronos/memiavl"
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+)
+
+const flagFix = "fix"
+
+func VerifyVersionDBCmd(defaultStores []string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-versiondb <memiavl-dir> <versiondb-dir>",
+		Short: "Compare the key-value pairs in versiondb against the memiavl state at the same version, report or fix the mismatches",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			targetVersion, err := cmd.Flags().GetInt64(flagTargetVersion)
+			if err != nil {
+				return err
+			}
+			concurrency, err := cmd.Flags().GetInt(flagConcurrency)
+			if err != nil {
+				return err
+			}
+			fix, err := cmd.Flags().GetBool(flagFix)
+			if err != nil {
+				return err
+			}
+			stores, err := GetStoresOrDefault(cmd, defaultStores)
+			if err != nil {
+				return err
+			}
+
+			db, err := memiavl.Load(args[0], memiavl.Options{
+				ReadOnly:      true,
+				TargetVersion: uint32(targetVersion),
+			})
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			version := db.Version()
+
+			var (
+				rocksDB  *grocksdb.DB
+				cfHandle *grocksdb.ColumnFamilyHandle
+			)
+			if fix {
+				rocksDB, cfHandle, err = tsrocksdb.OpenVersionDB(args[1])
+			} else {
+				rocksDB, cfHandle, err = tsrocksdb.OpenVersionDBForReadOnly(args[1], false)
+			}
+			if err != nil {
+				return err
+			}
+			versionDB := tsrocksdb.NewStoreWithDB(rocksDB, cfHandle)
+			// see: https://github.com/crypto-org-chain/cronos/issues/1683
+			versionDB.SetSkipVersionZero(true)
+
+			pool := pond.New(concurrency, len(stores))
+			defer pool.StopAndWait()
+			group, _ := pool.GroupContext(context.Background())
+
+			var (
+				total int
+				lock  sync.Mutex
+			)
+			for _, store := range stores {
+				// https://github.com/golang/go/wiki/CommonMistakes#using-goroutines-on-loop-iterator-variables
+				store := store
+				tree := db.TreeByName(store)
+				if tree == nil {
+					fmt.Printf("skip store %s, not found in memiavl\n", store)
+					continue
+				}
+				group.Submit(func() error {
+					var mismatches []versiondb.Mismatch
+					it := tree.Iterator(nil, nil, true)
+					defer it.Close()
+					if err := versiondb.DiffStore(versionDB, store, version, it, func(m versiondb.Mismatch) error {
+						mismatches = append(mismatches, m)
+						return nil
+					}); err != nil {
+						return err
+					}
+
+					lock.Lock()
+					for _, m := range mismatches {
+						fmt.Printf("mismatch: version: %d, %s\n", version, m.String())
+					}
+					total += len(mismatches)
+					lock.Unlock()
+
+					if !fix || len(mismatches) == 0 {
+						return nil
+					}
+					return versionDB.RepairAtVersion(version, mismatches)
+				})
+			}
+			if err := group.Wait(); err != nil {
+				return err
+			}
+
+			switch {
+			case total == 0:
+				fmt.Printf("version %d verified successfully\n", version)
+			case fix:
+				if err := versionDB.Flush(); err != nil {
+					return err
+				}
+				fmt.Printf("version %d fixed %d mismatches\n", version, total)
+			default:
+				return fmt.Errorf("version %d found %d mismatches", version, total)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagTargetVersion, 0, "specify the version to verify, default to the latest version of memiavl")
+	cmd.Flags().String(flagStores, "", "list of store names, default to the current store list in application")
+	cmd.Flags().Int(flagConcurrency, runtime.NumCPU(), "Number concurrent goroutines to parallelize the work")
+	cmd.Flags().Bool(flagFix, false, "Write the values of memiavl into versiondb at the version to fix the mismatches")
+	return cmd
+}
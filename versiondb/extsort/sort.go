@@ -70,34 +70,60 @@ func Spawn(tmpDir string, opts Options, bufferSize int) (chan []byte, chan []byt
 	go func() {
 		defer close(outputChan)
 
-		sorter := New(tmpDir, opts)
-		defer sorter.Close()
+		if err := sortItems(tmpDir, opts, inputChan, outputChan); err != nil {
+			panic(err)
+		}
+	}()
 
-		for bz := range inputChan {
-			if err := sorter.Feed(bz); err != nil {
-				panic(err)
-			}
+	return inputChan, outputChan
+}
+
+// SpawnWithError is similar to `Spawn`, but returns the error of the sorting through the returned function instead
+// of panic, the error is only available after the output channel is closed. After a failure, the input channel is
+// still drained until closed, so the producer is never blocked.
+func SpawnWithError(tmpDir string, opts Options, bufferSize int) (chan []byte, chan []byte, func() error) {
+	inputChan := make(chan []byte, bufferSize)
+	outputChan := make(chan []byte, bufferSize)
+
+	var sortErr error
+	go func() {
+		defer close(outputChan)
+
+		sortErr = sortItems(tmpDir, opts, inputChan, outputChan)
+		for range inputChan {
 		}
+	}()
 
-		reader, err := sorter.Finalize()
-		if err != nil {
-			panic(err)
+	return inputChan, outputChan, func() error { return sortErr }
+}
+
+// sortItems sorts the items received from the input channel, and sends the sorted items to the output channel.
+func sortItems(tmpDir string, opts Options, inputChan <-chan []byte, outputChan chan<- []byte) error {
+	sorter := New(tmpDir, opts)
+	defer sorter.Close()
+
+	for bz := range inputChan {
+		if err := sorter.Feed(bz); err != nil {
+			return err
 		}
+	}
 
-		for {
-			item, err := reader.Next()
-			if err != nil {
-				panic(err)
-			}
-			if item == nil {
-				break
-			}
+	reader, err := sorter.Finalize()
+	if err != nil {
+		return err
+	}
 
-			outputChan <- item
+	for {
+		item, err := reader.Next()
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			return nil
 		}
-	}()
 
-	return inputChan, outputChan
+		outputChan <- item
+	}
 }
 
 // Feed add un-ordered items to the sorter.
@@ -69,3 +69,37 @@ func TestExtSort(t *testing.T) {
 	doTestExtSorter(t, ItemSize*100, 1550)
 	doTestExtSorter(t, ItemSize*100, 155000)
 }
+
+func TestSpawnWithError(t *testing.T) {
+	opts := Options{
+		MaxChunkSize: 1,
+		LesserFunc: func(a, b []byte) bool {
+			return bytes.Compare(a, b) == -1
+		},
+	}
+
+	inputChan, outputChan, sortErr := SpawnWithError(t.TempDir(), opts, 1)
+	for i := 0; i < 10; i++ {
+		inputChan <- []byte(fmt.Sprintf(ItemTpl, 9-i))
+	}
+	close(inputChan)
+	var items [][]byte
+	for item := range outputChan {
+		items = append(items, item)
+	}
+	require.NoError(t, sortErr())
+	require.Len(t, items, 10)
+	require.True(t, sort.SliceIsSorted(items, func(i, j int) bool {
+		return bytes.Compare(items[i], items[j]) == -1
+	}))
+
+	// the chunk files can't be created, the producer is not blocked after the failure
+	inputChan, outputChan, sortErr = SpawnWithError("/non-existent-dir", opts, 1)
+	for i := 0; i < 10; i++ {
+		inputChan <- []byte(fmt.Sprintf(ItemTpl, i))
+	}
+	close(inputChan)
+	for range outputChan {
+	}
+	require.Error(t, sortErr())
+}
@@ -0,0 +1,58 @@
+package versiondb
+
+import (
+	"io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+const (
+	// SnapshotFormat format 1 is the full state at the base version, plus the raw history entries after it.
+	SnapshotFormat = 1
+
+	// SnapshotName is the name of the versiondb state-sync snapshot extension.
+	SnapshotName = "versiondb"
+)
+
+var _ snapshottypes.ExtensionSnapshotter = DiscardSnapshotter{}
+
+// DiscardSnapshotter is registered in place of the versiondb extension snapshotter when the snapshot extension is
+// not enabled, so the node can still restore the snapshots carrying the versiondb extension, the payloads are
+// simply discarded.
+//
+// It don't write any payload into the snapshots it creates, the restorers of the versiondb extension treat the
+// empty payload stream as nothing to restore.
+type DiscardSnapshotter struct{}
+
+// SnapshotName implements ExtensionSnapshotter interface
+func (DiscardSnapshotter) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements ExtensionSnapshotter interface
+func (DiscardSnapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements ExtensionSnapshotter interface
+func (DiscardSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension implements ExtensionSnapshotter interface, it writes nothing.
+func (DiscardSnapshotter) SnapshotExtension(uint64, snapshottypes.ExtensionPayloadWriter) error {
+	return nil
+}
+
+// RestoreExtension implements ExtensionSnapshotter interface, it reads and discards all the payloads.
+func (DiscardSnapshotter) RestoreExtension(_ uint64, _ uint32, payloadReader snapshottypes.ExtensionPayloadReader) error {
+	for {
+		_, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
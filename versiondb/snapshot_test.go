@@ -0,0 +1,31 @@
+package versiondb
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscardSnapshotter(t *testing.T) {
+	var s DiscardSnapshotter
+	require.Equal(t, SnapshotName, s.SnapshotName())
+	require.Equal(t, []uint32{SnapshotFormat}, s.SupportedFormats())
+
+	payloads := [][]byte{{0x0, 0x1}, {0x1}, {0x2, 0x1, 0x0}}
+	require.NoError(t, s.RestoreExtension(1, SnapshotFormat, func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}))
+	require.Empty(t, payloads)
+
+	expErr := errors.New("broken stream")
+	require.ErrorIs(t, s.RestoreExtension(1, SnapshotFormat, func() ([]byte, error) {
+		return nil, expErr
+	}), expErr)
+}
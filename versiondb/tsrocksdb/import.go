@@ -0,0 +1,237 @@
+package tsrocksdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/linxGnu/grocksdb"
+
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/crypto-org-chain/cronos/versiondb/extsort"
+)
+
+const (
+	// ImportSSTFileSize is the target size of the sst files generated in Import
+	ImportSSTFileSize = 128 * 1024 * 1024
+	// ImportSorterChunkSize is the uncompressed chunk size of the external sorter of each store in Import, the
+	// sorters of all the stores run in parallel, so the peak ram usage is roughly the number of stores times the chunk
+	// size, e.g. around 1.5GB for 24 stores.
+	ImportSorterChunkSize = 64 * 1024 * 1024
+
+	importBufferSize = 1024
+
+	// importKeyLenSize is the number of bytes used to encode key length in sort item
+	importKeyLenSize = 4
+)
+
+// Import loads the initial version of the state, the entries are routed to the external sorters of each store which
+// run in parallel, the sorted entries are written to sst files, which are ingested into the db in the end.
+//
+// The peak ram usage is roughly the number of stores times `ImportSorterChunkSize`. The channel is always drained
+// before returning, even on failure, so the producer is never blocked.
+func (s Store) Import(version int64, ch <-chan versiondb.ImportEntry) error {
+	defer func() {
+		for range ch {
+		}
+	}()
+
+	// put the temporary files on the same filesystem as the db, so the sst files can be moved rather than copied.
+	tmpDir, err := os.MkdirTemp(filepath.Dir(filepath.Clean(s.db.Name())), "versiondb-import-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// shared by the sst writers of all the stores, destroyed after they are all done.
+	envOpts := grocksdb.NewDefaultEnvOptions()
+	defer envOpts.Destroy()
+	sstOpts, bbto := newImportSSTFileWriterOpts()
+	defer func() {
+		sstOpts.Destroy()
+		bbto.Destroy()
+	}()
+
+	var ts [TimestampSize]byte
+	binary.LittleEndian.PutUint64(ts[:], uint64(version))
+
+	var (
+		wg       sync.WaitGroup
+		mtx      sync.Mutex
+		errs     []error
+		sstFiles []string
+	)
+	inputs := make(map[string]chan []byte)
+	for entry := range ch {
+		input, ok := inputs[entry.StoreKey]
+		if !ok {
+			// store names are not necessarily valid file names
+			storeDir := filepath.Join(tmpDir, fmt.Sprintf("store-%d", len(inputs)))
+			if err := os.Mkdir(storeDir, os.ModePerm); err != nil {
+				closeImportInputs(inputs, &wg)
+				return err
+			}
+
+			var (
+				output  chan []byte
+				sortErr func() error
+			)
+			input, output, sortErr = extsort.SpawnWithError(storeDir, extsort.Options{
+				MaxChunkSize:      ImportSorterChunkSize,
+				LesserFunc:        compareImportItem,
+				DeltaEncoding:     true,
+				SnappyCompression: true,
+			}, importBufferSize)
+			inputs[entry.StoreKey] = input
+
+			wg.Add(1)
+			go func(storeKey string) {
+				defer wg.Done()
+
+				files, err := writeImportSSTFiles(envOpts, sstOpts, storeKey, storeDir, ts[:], output)
+				// the output is drained, so the sorter is done
+				err = errors.Join(err, sortErr())
+
+				mtx.Lock()
+				defer mtx.Unlock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("import store %s: %w", storeKey, err))
+				}
+				sstFiles = append(sstFiles, files...)
+			}(entry.StoreKey)
+		}
+
+		input <- encodeImportItem(entry.Key, entry.Value)
+	}
+
+	closeImportInputs(inputs, &wg)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if len(sstFiles) > 0 {
+		// deterministic order, the files of different stores don't overlap with each other.
+		sort.Strings(sstFiles)
+
+		ingestOpts := grocksdb.NewDefaultIngestExternalFileOptions()
+		defer ingestOpts.Destroy()
+		ingestOpts.SetMoveFiles(true)
+		if err := s.db.IngestExternalFileCF(s.cfHandle, sstFiles, ingestOpts); err != nil {
+			return err
+		}
+	}
+
+	return s.SetLatestVersion(version)
+}
+
+// closeImportInputs closes the sorter inputs, and waits for the sst writers to finish.
+func closeImportInputs(inputs map[string]chan []byte, wg *sync.WaitGroup) {
+	for _, input := range inputs {
+		close(input)
+	}
+	wg.Wait()
+}
+
+// newImportSSTFileWriterOpts returns the options of the sst files generated in Import, the table format is the same as
+// the versiondb column family, but without the block cache, which is only used for reading, the caller should destroy
+// both the options and the table options after the writers are done.
+func newImportSSTFileWriterOpts() (*grocksdb.Options, *grocksdb.BlockBasedTableOptions) {
+	opts := grocksdb.NewDefaultOptions()
+	opts.SetComparator(CreateTSComparator())
+
+	bbto := grocksdb.NewDefaultBlockBasedTableOptions()
+	bbto.SetBlockSize(32 * 1024)
+	bbto.SetNoBlockCache(true)
+	bbto.SetFilterPolicy(grocksdb.NewRibbonHybridFilterPolicy(9.9, 1))
+	bbto.SetIndexType(grocksdb.KBinarySearchWithFirstKey)
+	bbto.SetOptimizeFiltersForMemory(true)
+	opts.SetBlockBasedTableFactory(bbto)
+	opts.SetCompressionOptionsParallelThreads(4)
+	return opts, bbto
+}
+
+// writeImportSSTFiles writes the sorted items of a store to sst files, returns the file names,
+// it drains the sorter output in case of failure, to not block the sorter.
+func writeImportSSTFiles(
+	envOpts *grocksdb.EnvOptions, opts *grocksdb.Options, storeKey, dir string, ts []byte, output <-chan []byte,
+) (files []string, err error) {
+	defer func() {
+		for range output {
+		}
+	}()
+
+	sstWriter := grocksdb.NewSSTFileWriter(envOpts, opts)
+	defer sstWriter.Destroy()
+
+	openNextFile := func() error {
+		name := filepath.Join(dir, fmt.Sprintf("%d.sst", len(files)))
+		if err := sstWriter.Open(name); err != nil {
+			return err
+		}
+		files = append(files, name)
+		return nil
+	}
+
+	prefix := storePrefix(storeKey)
+	for item := range output {
+		key, value := decodeImportItem(item)
+		if len(files) == 0 {
+			if err := openNextFile(); err != nil {
+				return nil, err
+			}
+		} else if sstWriter.FileSize() >= ImportSSTFileSize {
+			// the keys are unique in a single version, so it's safe to split the file at any key.
+			if err := sstWriter.Finish(); err != nil {
+				return nil, err
+			}
+			if err := openNextFile(); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := sstWriter.PutWithTS(cloneAppend(prefix, key), ts, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(files) == 0 {
+		// SSTFileWriter don't support writing empty files
+		return nil, nil
+	}
+	if err := sstWriter.Finish(); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// encodeImportItem encodes the key-value pair for the external sorter.
+//
+// layout: key + value + key length(importKeyLenSize)
+// the key is put in the front to take advantage of the delta encoding in the `ExtSorter`.
+func encodeImportItem(key, value []byte) []byte {
+	item := make([]byte, len(key)+len(value)+importKeyLenSize)
+	copy(item, key)
+	copy(item[len(key):], value)
+	binary.LittleEndian.PutUint32(item[len(key)+len(value):], uint32(len(key)))
+	return item
+}
+
+// decodeImportItem decodes the key-value pair from external sorter.
+//
+// see godoc of `encodeImportItem` for layout
+func decodeImportItem(item []byte) ([]byte, []byte) {
+	keyLen := binary.LittleEndian.Uint32(item[len(item)-importKeyLenSize:])
+	return item[:keyLen], item[keyLen : len(item)-importKeyLenSize]
+}
+
+// compareImportItem compares the keys of encoded items, returns if a < b.
+func compareImportItem(a, b []byte) bool {
+	aKeyLen := binary.LittleEndian.Uint32(a[len(a)-importKeyLenSize:])
+	bKeyLen := binary.LittleEndian.Uint32(b[len(b)-importKeyLenSize:])
+	return bytes.Compare(a[:aKeyLen], b[:bKeyLen]) == -1
+}
@@ -0,0 +1,63 @@
+package tsrocksdb
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImport(t *testing.T) {
+	stores := []string{"acc", "bank", "evm"}
+
+	var entries []versiondb.ImportEntry
+	for _, store := range stores {
+		for i := 0; i < 1000; i++ {
+			entries = append(entries, versiondb.ImportEntry{
+				StoreKey: store,
+				Key:      []byte(fmt.Sprintf("key-%04d", i)),
+				Value:    []byte(fmt.Sprintf("%s-value-%d", store, i)),
+			})
+		}
+	}
+	// the entries of different stores are interleaved, and unsorted in each store
+	rand.Shuffle(len(entries), func(i, j int) {
+		entries[i], entries[j] = entries[j], entries[i]
+	})
+
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	ch := make(chan versiondb.ImportEntry, 128)
+	go func() {
+		defer close(ch)
+		for _, entry := range entries {
+			ch <- entry
+		}
+	}()
+	require.NoError(t, store.Import(10, ch))
+
+	latest, err := store.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), latest)
+
+	version := int64(10)
+	for _, name := range stores {
+		it, err := store.IteratorAtVersion(name, nil, nil, &version)
+		require.NoError(t, err)
+		kvs := consumeIterator(it)
+		require.Equal(t, 1000, len(kvs))
+		for i, kv := range kvs {
+			require.Equal(t, []byte(fmt.Sprintf("key-%04d", i)), kv.Key)
+			require.Equal(t, []byte(fmt.Sprintf("%s-value-%d", name, i)), kv.Value)
+		}
+
+		// not visible before the imported version
+		older := version - 1
+		it, err = store.IteratorAtVersion(name, nil, nil, &older)
+		require.NoError(t, err)
+		require.Empty(t, consumeIterator(it))
+	}
+}
@@ -78,6 +78,23 @@ func OpenVersionDBForReadOnly(dir string, errorIfWalFileExists bool) (*grocksdb.
 	return db, cfHandles[1], nil
 }
 
+// OpenVersionDBAsSecondary opens versiondb as a secondary instance of the primary one in `dir`, it's read-only and
+// could run in another process, call `TryCatchUpWithPrimary` to apply the new writes of the primary instance,
+// `secondaryDir` is where the secondary instance stores its own info log.
+func OpenVersionDBAsSecondary(dir, secondaryDir string) (*grocksdb.DB, *grocksdb.ColumnFamilyHandle, error) {
+	opts := grocksdb.NewDefaultOptions()
+	// secondary instance requires to keep all the files open
+	opts.SetMaxOpenFiles(-1)
+	db, cfHandles, err := grocksdb.OpenDbAsSecondaryColumnFamilies(
+		opts, dir, secondaryDir, []string{"default", VersionDBCFName},
+		[]*grocksdb.Options{opts, NewVersionDBOpts(false)},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, cfHandles[1], nil
+}
+
 // OpenVersionDBAndTrimHistory opens versiondb similar to `OpenVersionDB`,
 // but it also trim the versions newer than target one, can be used for rollback.
 func OpenVersionDBAndTrimHistory(dir string, version int64) (*grocksdb.DB, *grocksdb.ColumnFamilyHandle, error) {
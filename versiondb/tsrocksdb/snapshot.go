@@ -0,0 +1,360 @@
+package tsrocksdb
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/linxGnu/grocksdb"
+)
+
+const (
+	SnapshotFormat = versiondb.SnapshotFormat
+	SnapshotName   = versiondb.SnapshotName
+)
+
+const (
+	payloadTypeHeader byte = iota
+	payloadTypeStore
+	payloadTypeKV
+
+	// value types of rocksdb internal key
+	valueTypeDeletion              = 0x0
+	valueTypeValue                 = 0x1
+	valueTypeSingleDeletion        = 0x7
+	valueTypeDeletionWithTimestamp = 0x14
+
+	// the size of packed sequence number and value type in internal key
+	internalKeyTrailerSize = 8
+)
+
+var _ snapshottypes.ExtensionSnapshotter = &ExtensionSnapshotter{}
+
+// ExtensionSnapshotter appends the versiondb contents to the state-sync snapshot stream,
+// so the state-synced node could have the versiondb ready at the snapshot height.
+type ExtensionSnapshotter struct {
+	store  Store
+	stores []string
+	// history is the number of versions of history to include in the snapshot, besides the state at snapshot height.
+	history int64
+}
+
+// NewExtensionSnapshotter creates a new ExtensionSnapshotter, `history` is the number of versions before the snapshot
+// height to include, 0 means only the state at snapshot height.
+func NewExtensionSnapshotter(store Store, stores []string, history int64) *ExtensionSnapshotter {
+	return &ExtensionSnapshotter{
+		store:   store,
+		stores:  stores,
+		history: history,
+	}
+}
+
+// SnapshotName implements ExtensionSnapshotter interface
+func (s *ExtensionSnapshotter) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements ExtensionSnapshotter interface
+func (s *ExtensionSnapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements ExtensionSnapshotter interface
+func (s *ExtensionSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension implements ExtensionSnapshotter interface, it writes the full state at the base version,
+// then the history entries between the base version and snapshot height.
+//
+// Payload format:
+// ```
+// header: type(1) | base version: varint-uint64
+// store:  type(1) | store name
+// kv:     type(1) | version: varint-uint64 | delete: int8 | keyLen: varint-uint64 | key | value
+// ```
+func (s *ExtensionSnapshotter) SnapshotExtension(height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+	baseVersion := int64(height) - s.history
+	if baseVersion < 0 {
+		baseVersion = 0
+	}
+	if err := payloadWriter(binary.AppendUvarint([]byte{payloadTypeHeader}, uint64(baseVersion))); err != nil {
+		return err
+	}
+
+	for _, storeKey := range s.stores {
+		if err := payloadWriter(append([]byte{payloadTypeStore}, storeKey...)); err != nil {
+			return err
+		}
+
+		if err := s.exportState(storeKey, baseVersion, payloadWriter); err != nil {
+			return err
+		}
+
+		if baseVersion < int64(height) {
+			if err := s.store.iterateHistory(storeKey, baseVersion+1, int64(height), func(version int64, key, value []byte, deleted bool) error {
+				return payloadWriter(encodeKVPayload(version, key, value, deleted))
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ExtensionSnapshotter) exportState(storeKey string, version int64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+	it, err := s.store.IteratorAtVersion(storeKey, nil, nil, &version)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := payloadWriter(encodeKVPayload(version, it.Key(), it.Value(), false)); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// RestoreExtension implements ExtensionSnapshotter interface, the state at the base version is imported with
+// `VersionStore.Import`, the history entries are spilled into a temporary file meanwhile, and written with their own
+// versions after the import is finished, so the ingestion and the writes don't overlap on the column family.
+func (s *ExtensionSnapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader) error {
+	if format != SnapshotFormat {
+		return fmt.Errorf("unsupported versiondb snapshot format: %d", format)
+	}
+
+	payload, err := payloadReader()
+	if err == io.EOF {
+		// the snapshot is created by a node without the extension enabled, see `versiondb.DiscardSnapshotter`.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(payload) == 0 || payload[0] != payloadTypeHeader {
+		return errors.New("invalid versiondb snapshot, header not found")
+	}
+	baseVersion, n := binary.Uvarint(payload[1:])
+	if n <= 0 {
+		return errors.New("invalid versiondb snapshot header")
+	}
+
+	spill, err := os.CreateTemp("", "versiondb-snapshot-history-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		spill.Close()
+		os.Remove(spill.Name())
+	}()
+
+	ch := make(chan versiondb.ImportEntry, 128)
+	importErr := make(chan error, 1)
+	go func() {
+		err := s.store.Import(int64(baseVersion), ch)
+		// drain the channel to unblock the reader in case of failure
+		for range ch {
+		}
+		importErr <- err
+	}()
+
+	writer := bufio.NewWriter(spill)
+	err = s.restorePayloads(baseVersion, payloadReader, ch, writer)
+	close(ch)
+	if err := errors.Join(err, <-importErr, writer.Flush()); err != nil {
+		return err
+	}
+
+	if _, err := spill.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := s.writeHistory(bufio.NewReader(spill)); err != nil {
+		return err
+	}
+
+	return s.store.SetLatestVersion(int64(height))
+}
+
+// restorePayloads sends the state at the base version to the importer, and spills the other payloads to the writer,
+// each prefixed with its length.
+func (s *ExtensionSnapshotter) restorePayloads(
+	baseVersion uint64,
+	payloadReader snapshottypes.ExtensionPayloadReader,
+	ch chan<- versiondb.ImportEntry,
+	spill io.Writer,
+) error {
+	var storeKey string
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if len(payload) == 0 {
+			return errors.New("invalid versiondb snapshot, empty payload")
+		}
+
+		switch payload[0] {
+		case payloadTypeStore:
+			storeKey = string(payload[1:])
+		case payloadTypeKV:
+			if storeKey == "" {
+				return errors.New("invalid versiondb snapshot, store name is empty")
+			}
+			version, key, value, deleted, err := decodeKVPayload(payload)
+			if err != nil {
+				return err
+			}
+			if version == baseVersion && !deleted {
+				ch <- versiondb.ImportEntry{StoreKey: storeKey, Key: key, Value: value}
+				continue
+			}
+		default:
+			return fmt.Errorf("invalid versiondb snapshot, unknown payload type: %d", payload[0])
+		}
+
+		if _, err := spill.Write(binary.AppendUvarint(nil, uint64(len(payload)))); err != nil {
+			return err
+		}
+		if _, err := spill.Write(payload); err != nil {
+			return err
+		}
+	}
+}
+
+// writeHistory writes the spilled history entries with their own versions.
+func (s *ExtensionSnapshotter) writeHistory(reader *bufio.Reader) error {
+	batch := grocksdb.NewWriteBatch()
+	defer batch.Destroy()
+
+	var storeKey string
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			return err
+		}
+
+		if payload[0] == payloadTypeStore {
+			storeKey = string(payload[1:])
+			continue
+		}
+		version, key, value, deleted, err := decodeKVPayload(payload)
+		if err != nil {
+			return err
+		}
+
+		var ts [TimestampSize]byte
+		binary.LittleEndian.PutUint64(ts[:], version)
+		if deleted {
+			batch.DeleteCFWithTS(s.store.cfHandle, prependStoreKey(storeKey, key), ts[:])
+		} else {
+			batch.PutCFWithTS(s.store.cfHandle, prependStoreKey(storeKey, key), ts[:], value)
+		}
+		if batch.Count() >= ImportCommitBatchSize {
+			if err := s.store.db.Write(defaultWriteOpts, batch); err != nil {
+				return err
+			}
+			batch.Clear()
+		}
+	}
+
+	if batch.Count() > 0 {
+		return s.store.db.Write(defaultWriteOpts, batch)
+	}
+	return nil
+}
+
+func encodeKVPayload(version int64, key, value []byte, deleted bool) []byte {
+	buf := make([]byte, 0, 1+binary.MaxVarintLen64*2+1+len(key)+len(value))
+	buf = append(buf, payloadTypeKV)
+	buf = binary.AppendUvarint(buf, uint64(version))
+	if deleted {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(key)))
+	buf = append(buf, key...)
+	return append(buf, value...)
+}
+
+func decodeKVPayload(payload []byte) (version uint64, key, value []byte, deleted bool, err error) {
+	buf := payload[1:]
+	version, n := binary.Uvarint(buf)
+	if n <= 0 {
+		return 0, nil, nil, false, errors.New("invalid versiondb snapshot, invalid version")
+	}
+	buf = buf[n:]
+	if len(buf) == 0 {
+		return 0, nil, nil, false, errors.New("invalid versiondb snapshot, missing delete flag")
+	}
+	deleted = buf[0] != 0
+	buf = buf[1:]
+	keyLen, n := binary.Uvarint(buf)
+	if n <= 0 || uint64(len(buf)-n) < keyLen {
+		return 0, nil, nil, false, errors.New("invalid versiondb snapshot, invalid key")
+	}
+	buf = buf[n:]
+	return version, buf[:keyLen], buf[keyLen:], deleted, nil
+}
+
+// iterateHistory iterates all the entries written in the version range `[start, end]` in the store, including the
+// deletions, the entries are ordered by key, and newer version comes first for the same key.
+func (s Store) iterateHistory(storeKey string, start, end int64, fn func(version int64, key, value []byte, deleted bool) error) error {
+	var startTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(startTS[:], uint64(start))
+
+	readOpts := newTSReadOptions(&end)
+	defer readOpts.Destroy()
+	// with iter_start_ts set, the iterator returns all the versions in range, and the key is the internal key.
+	readOpts.SetIterStartTimestamp(startTS[:])
+
+	prefix := storePrefix(storeKey)
+	itr := s.db.NewIteratorCF(readOpts, s.cfHandle)
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		internalKey := moveSliceToBytes(itr.Key())
+		if len(internalKey) < len(prefix)+TimestampSize+internalKeyTrailerSize {
+			return fmt.Errorf("invalid internal key: %X", internalKey)
+		}
+		trailerPos := len(internalKey) - internalKeyTrailerSize
+		tsPos := trailerPos - TimestampSize
+		version := int64(binary.LittleEndian.Uint64(internalKey[tsPos:trailerPos]))
+		key := internalKey[len(prefix):tsPos]
+
+		var deleted bool
+		switch valueType := internalKey[trailerPos]; valueType {
+		case valueTypeValue:
+		case valueTypeDeletion, valueTypeSingleDeletion, valueTypeDeletionWithTimestamp:
+			deleted = true
+		default:
+			return fmt.Errorf("unsupported value type %d, key: %X", valueType, internalKey)
+		}
+
+		var value []byte
+		if !deleted {
+			value = moveSliceToBytes(itr.Value())
+		}
+		if err := fn(version, key, value, deleted); err != nil {
+			return err
+		}
+	}
+
+	return itr.Err()
+}
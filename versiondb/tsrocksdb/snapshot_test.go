@@ -0,0 +1,56 @@
+package tsrocksdb
+
+import (
+	"io"
+	"testing"
+
+	"github.com/crypto-org-chain/cronos/versiondb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotExtension(t *testing.T) {
+	stores := []string{"evm", "staking"}
+
+	source, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+	versiondb.SetupTestDB(t, source)
+
+	height := uint64(4)
+	for _, history := range []int64{0, 2, 10} {
+		var payloads [][]byte
+		snapshotter := NewExtensionSnapshotter(source, stores, history)
+		require.NoError(t, snapshotter.SnapshotExtension(height, func(payload []byte) error {
+			payloads = append(payloads, payload)
+			return nil
+		}))
+
+		target, err := NewStore(t.TempDir())
+		require.NoError(t, err)
+		restorer := NewExtensionSnapshotter(target, stores, history)
+		require.NoError(t, restorer.RestoreExtension(height, SnapshotFormat, func() ([]byte, error) {
+			if len(payloads) == 0 {
+				return nil, io.EOF
+			}
+			payload := payloads[0]
+			payloads = payloads[1:]
+			return payload, nil
+		}))
+
+		latest, err := target.GetLatestVersion()
+		require.NoError(t, err)
+		require.Equal(t, int64(height), latest)
+
+		for version := int64(height) - history; version <= int64(height); version++ {
+			if version < 0 {
+				continue
+			}
+			for _, store := range stores {
+				expected, err := source.IteratorAtVersion(store, nil, nil, &version)
+				require.NoError(t, err)
+				actual, err := target.IteratorAtVersion(store, nil, nil, &version)
+				require.NoError(t, err)
+				require.Equal(t, consumeIterator(expected), consumeIterator(actual), "version %d, store %s", version, store)
+			}
+		}
+	}
+}
@@ -2,11 +2,14 @@ package tsrocksdb
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
+	"cosmossdk.io/log"
 	"cosmossdk.io/store/types"
 	"github.com/cosmos/iavl"
 	"github.com/crypto-org-chain/cronos/versiondb"
@@ -42,6 +45,9 @@ type Store struct {
 
 	// see: https://github.com/crypto-org-chain/cronos/issues/1683
 	skipVersionZero bool
+
+	// secondary is true if the db is opened as a secondary instance, which is read-only.
+	secondary bool
 }
 
 func NewStore(dir string) (Store, error) {
@@ -62,6 +68,20 @@ func NewStoreWithDB(db *grocksdb.DB, cfHandle *grocksdb.ColumnFamilyHandle) Stor
 	}
 }
 
+// NewSecondaryStore opens the versiondb in `dir` as a secondary instance, the secondary instance can run in another
+// process than the primary one, it's read-only and needs to call `TryCatchUpWithPrimary` to see the new versions.
+func NewSecondaryStore(dir, secondaryDir string) (Store, error) {
+	db, cfHandle, err := OpenVersionDBAsSecondary(dir, secondaryDir)
+	if err != nil {
+		return Store{}, err
+	}
+	return Store{
+		db:        db,
+		cfHandle:  cfHandle,
+		secondary: true,
+	}, nil
+}
+
 func (s *Store) SetSkipVersionZero(skip bool) {
 	s.skipVersionZero = skip
 }
@@ -195,38 +215,12 @@ func (s Store) FeedChangeSet(version int64, store string, changeSet *iavl.Change
 	return s.db.Write(defaultWriteOpts, batch)
 }
 
-// Import loads the initial version of the state
-func (s Store) Import(version int64, ch <-chan versiondb.ImportEntry) error {
-	batch := grocksdb.NewWriteBatch()
-	defer batch.Destroy()
-
-	var ts [TimestampSize]byte
-	binary.LittleEndian.PutUint64(ts[:], uint64(version))
-
-	var counter int
-	for entry := range ch {
-		key := cloneAppend(storePrefix(entry.StoreKey), entry.Key)
-		batch.PutCFWithTS(s.cfHandle, key, ts[:], entry.Value)
-
-		counter++
-		if counter%ImportCommitBatchSize == 0 {
-			if err := s.db.Write(defaultWriteOpts, batch); err != nil {
-				return err
-			}
-			batch.Clear()
-		}
-	}
-
-	if batch.Count() > 0 {
-		if err := s.db.Write(defaultWriteOpts, batch); err != nil {
-			return err
-		}
+func (s Store) Flush() error {
+	if s.secondary {
+		// nothing to flush for secondary instance
+		return nil
 	}
 
-	return s.SetLatestVersion(version)
-}
-
-func (s Store) Flush() error {
 	opts := grocksdb.NewDefaultFlushOptions()
 	defer opts.Destroy()
 
@@ -236,6 +230,38 @@ func (s Store) Flush() error {
 	)
 }
 
+// Close releases the column family handle and closes the db, the store is not usable after it.
+func (s Store) Close() {
+	s.cfHandle.Destroy()
+	s.db.Close()
+}
+
+// TryCatchUpWithPrimary applies the new writes of the primary instance, only valid for secondary instance.
+func (s Store) TryCatchUpWithPrimary() error {
+	if !s.secondary {
+		return errors.New("not a secondary instance")
+	}
+	return s.db.TryCatchUpWithPrimary()
+}
+
+// CatchUpWithPrimary calls `TryCatchUpWithPrimary` periodically until the context is done,
+// the failures are logged and retried in the next round.
+func (s Store) CatchUpWithPrimary(ctx context.Context, interval time.Duration, logger log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.TryCatchUpWithPrimary(); err != nil {
+				logger.Error("versiondb failed to catch up with primary", "error", err)
+			}
+		}
+	}
+}
+
 // FixData fixes wrong data written in versiondb due to rocksdb upgrade, the operation is idempotent.
 // see: https://github.com/crypto-org-chain/cronos/issues/1683
 // call this before `SetSkipVersionZero(true)`.
@@ -297,6 +323,27 @@ func (s Store) fixDataStore(storeName string, dryRun bool) error {
 	return nil
 }
 
+// RepairAtVersion writes the expected values of the mismatches at the version, so the state at the version is
+// consistent with the reference state, the history before the version is not touched.
+func (s Store) RepairAtVersion(version int64, mismatches []versiondb.Mismatch) error {
+	var ts [TimestampSize]byte
+	binary.LittleEndian.PutUint64(ts[:], uint64(version))
+
+	batch := grocksdb.NewWriteBatch()
+	defer batch.Destroy()
+
+	for _, m := range mismatches {
+		key := prependStoreKey(m.StoreKey, m.Key)
+		if m.Expected == nil {
+			batch.DeleteCFWithTS(s.cfHandle, key, ts[:])
+		} else {
+			batch.PutCFWithTS(s.cfHandle, key, ts[:], m.Expected)
+		}
+	}
+
+	return s.db.Write(defaultSyncWriteOpts, batch)
+}
+
 type KVPairWithTS struct {
 	Key       []byte
 	Value     []byte
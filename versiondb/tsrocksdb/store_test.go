@@ -227,6 +227,49 @@ func TestSkipVersionZero(t *testing.T) {
 	require.Equal(t, []byte{2}, bz)
 }
 
+func TestDiffAndRepair(t *testing.T) {
+	storeKey := "test"
+
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	err = store.PutAtVersion(1, []*types.StoreKVPair{
+		{StoreKey: storeKey, Key: []byte("hello1"), Value: []byte{1}},
+		{StoreKey: storeKey, Key: []byte("hello2"), Value: []byte{2}},
+		{StoreKey: storeKey, Key: []byte("hello3"), Value: []byte{3}},
+	})
+	require.NoError(t, err)
+
+	// the reference state at version 1
+	expected := dbm.NewMemDB()
+	require.NoError(t, expected.Set([]byte("hello0"), []byte{0}))
+	require.NoError(t, expected.Set([]byte("hello1"), []byte{1}))
+	require.NoError(t, expected.Set([]byte("hello2"), []byte{4}))
+
+	diff := func() []versiondb.Mismatch {
+		it, err := expected.Iterator(nil, nil)
+		require.NoError(t, err)
+		defer it.Close()
+
+		var mismatches []versiondb.Mismatch
+		require.NoError(t, versiondb.DiffStore(store, storeKey, 1, it, func(m versiondb.Mismatch) error {
+			mismatches = append(mismatches, m)
+			return nil
+		}))
+		return mismatches
+	}
+
+	mismatches := diff()
+	require.Equal(t, []versiondb.Mismatch{
+		{StoreKey: storeKey, Key: []byte("hello0"), Expected: []byte{0}},
+		{StoreKey: storeKey, Key: []byte("hello2"), Expected: []byte{4}, Actual: []byte{2}},
+		{StoreKey: storeKey, Key: []byte("hello3"), Actual: []byte{3}},
+	}, mismatches)
+
+	require.NoError(t, store.RepairAtVersion(1, mismatches))
+	require.Empty(t, diff())
+}
+
 type kvPair struct {
 	Key   []byte
 	Value []byte
@@ -240,3 +283,37 @@ func consumeIterator(it dbm.Iterator) []kvPair {
 	it.Close()
 	return result
 }
+
+func TestSecondaryStore(t *testing.T) {
+	dir := t.TempDir()
+	primary, err := NewStore(dir)
+	require.NoError(t, err)
+	versiondb.SetupTestDB(t, primary)
+
+	secondary, err := NewSecondaryStore(dir, t.TempDir())
+	require.NoError(t, err)
+	latest, err := secondary.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), latest)
+
+	// the new writes are not visible before catch up
+	require.NoError(t, primary.PutAtVersion(5, []*types.StoreKVPair{
+		{StoreKey: "evm", Key: []byte("add-in-block5"), Value: []byte("1")},
+	}))
+	latest, err = secondary.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), latest)
+
+	require.NoError(t, secondary.TryCatchUpWithPrimary())
+	latest, err = secondary.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latest)
+	value, err := secondary.GetAtVersion("evm", []byte("add-in-block5"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+
+	// writes are rejected, flush is a no-op
+	require.Error(t, secondary.PutAtVersion(6, nil))
+	require.NoError(t, secondary.Flush())
+	require.Error(t, primary.TryCatchUpWithPrimary())
+}
@@ -0,0 +1,208 @@
+package versiondb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/types"
+)
+
+// Mismatch describes a key whose value in versiondb don't match the reference state.
+type Mismatch struct {
+	StoreKey string
+	Key      []byte
+	// Expected is the value in reference state, nil if the key don't exist there.
+	Expected []byte
+	// Actual is the value in versiondb, nil if the key don't exist there.
+	Actual []byte
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("store: %s, key: %X, expected: %X, actual: %X", m.StoreKey, m.Key, m.Expected, m.Actual)
+}
+
+// DiffStore compares the key-value pairs of a store in versiondb at the version against the reference iterator,
+// with a streaming merge of the two ordered iterators, the callback is called for each mismatch found.
+// The reference iterator must be ascending and cover the whole store, it's not closed by this function.
+func DiffStore(versionDB VersionStore, storeKey string, version int64, expected types.Iterator, fn func(Mismatch) error) error {
+	actual, err := versionDB.IteratorAtVersion(storeKey, nil, nil, &version)
+	if err != nil {
+		return err
+	}
+	defer actual.Close()
+
+	for expected.Valid() || actual.Valid() {
+		var cmp int
+		switch {
+		case !expected.Valid():
+			cmp = 1
+		case !actual.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(expected.Key(), actual.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			// missing in versiondb
+			if err := fn(Mismatch{
+				StoreKey: storeKey,
+				Key:      bytes.Clone(expected.Key()),
+				Expected: cloneValue(expected.Value()),
+			}); err != nil {
+				return err
+			}
+			expected.Next()
+		case cmp > 0:
+			// should have been deleted in versiondb
+			if err := fn(Mismatch{
+				StoreKey: storeKey,
+				Key:      actual.Key(),
+				Actual:   actual.Value(),
+			}); err != nil {
+				return err
+			}
+			actual.Next()
+		default:
+			if value := actual.Value(); !bytes.Equal(expected.Value(), value) {
+				if err := fn(Mismatch{
+					StoreKey: storeKey,
+					Key:      bytes.Clone(expected.Key()),
+					Expected: cloneValue(expected.Value()),
+					Actual:   value,
+				}); err != nil {
+					return err
+				}
+			}
+			expected.Next()
+			actual.Next()
+		}
+	}
+
+	return errors.Join(expected.Error(), actual.Error())
+}
+
+// DiffMultiStore compares all the stores of the multistore against versiondb at the version,
+// the multistore should be branched out at the same version, returns the number of mismatches found.
+func DiffMultiStore(
+	versionDB VersionStore,
+	ms types.MultiStore,
+	storeKeys map[string]*types.KVStoreKey,
+	version int64,
+	fn func(Mismatch) error,
+) (int, error) {
+	names := make([]string, 0, len(storeKeys))
+	for name := range storeKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var count int
+	for _, name := range names {
+		it := ms.GetKVStore(storeKeys[name]).Iterator(nil, nil)
+		err := DiffStore(versionDB, name, version, it, func(m Mismatch) error {
+			count++
+			return fn(m)
+		})
+		it.Close()
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+var _ types.ABCIListener = &Verifier{}
+
+// Verifier checks the consistency between versiondb and the committed state periodically in background,
+// it don't repair the data, the mismatches are reported in logs, user can use the `verify-versiondb`
+// command to repair them offline.
+type Verifier struct {
+	versionDB VersionStore
+	cms       types.CommitMultiStore
+	storeKeys map[string]*types.KVStoreKey
+	interval  int64
+	logger    log.Logger
+
+	// at most one check in flight
+	running atomic.Bool
+}
+
+// NewVerifier creates a new Verifier, it checks the previous version every `interval` blocks, zero disables it.
+// Each check branches out the committed state at the version, which loads a historical snapshot on memiavl.
+func NewVerifier(
+	versionDB VersionStore,
+	cms types.CommitMultiStore,
+	storeKeys map[string]*types.KVStoreKey,
+	interval int64,
+	logger log.Logger,
+) *Verifier {
+	return &Verifier{
+		versionDB: versionDB,
+		cms:       cms,
+		storeKeys: storeKeys,
+		interval:  interval,
+		logger:    logger.With("module", "versiondb-verifier"),
+	}
+}
+
+// ListenFinalizeBlock satisfies the types.ABCIListener interface
+func (v *Verifier) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit satisfies the types.ABCIListener interface, it triggers a background check of the previous version,
+// because the latest version could still be modified by the next block.
+func (v *Verifier) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	version := v.cms.LastCommitID().Version
+	if v.interval <= 0 || version <= 1 || version%v.interval != 0 {
+		return nil
+	}
+	if !v.running.CompareAndSwap(false, true) {
+		v.logger.Info("skip the check, the previous one is still running", "version", version-1)
+		return nil
+	}
+
+	go func() {
+		defer v.running.Store(false)
+		v.check(version - 1)
+	}()
+	return nil
+}
+
+func (v *Verifier) check(version int64) {
+	ms, err := v.cms.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		v.logger.Error("failed to load the committed state", "version", version, "err", err)
+		return
+	}
+
+	count, err := DiffMultiStore(v.versionDB, ms, v.storeKeys, version, func(m Mismatch) error {
+		v.logger.Error("versiondb mismatch", "version", version, "mismatch", m.String())
+		return nil
+	})
+	if err != nil {
+		v.logger.Error("failed to verify versiondb", "version", version, "err", err)
+		return
+	}
+	if count > 0 {
+		v.logger.Error("versiondb is inconsistent with the committed state", "version", version, "mismatches", count)
+		return
+	}
+	v.logger.Info("versiondb verified", "version", version)
+}
+
+// cloneValue copies the value, and never returns nil for existing key, to distinguish it from a missing key.
+func cloneValue(value []byte) []byte {
+	if value == nil {
+		return []byte{}
+	}
+	return bytes.Clone(value)
+}
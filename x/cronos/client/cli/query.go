@@ -29,6 +29,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetDenomByContractCmd(),
 		QueryParamsCmd(),
 		GetPermissions(),
+		GetBridgeStatusCmd(),
+		GetRateLimitsCmd(),
+		GetLogHandlersCmd(),
+		GetFailedLogsCmd(),
+		GetTokenMappingsCmd(),
+		GetRolesCmd(),
+		GetTokenSuppliesCmd(),
 	)
 
 	// this line is used by starport scaffolding # 1
@@ -133,18 +140,26 @@ func GetDenomByContractCmd() *cobra.Command {
 func GetPermissions() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "permissions [addr]",
-		Short: "Gets the permissions of a specific address",
-		Args:  cobra.ExactArgs(1),
+		Short: "Gets the permissions and role grants of a specific address, or the role grants of all accounts if address is omitted",
+		Args:  cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			req := &types.QueryPermissionsRequest{
-				Address: args[0],
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				req.Address = args[0]
 			}
 
 			res, err := queryClient.Permissions(rpctypes.ContextWithHeight(clientCtx.Height), req)
@@ -157,5 +172,230 @@ func GetPermissions() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "permissions")
+	return cmd
+}
+
+// GetRolesCmd queries all the roles
+func GetRolesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "Gets all the roles, including the built-in ones",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Roles(cmd.Context(), &types.QueryRolesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenSuppliesCmd checks the supplies of the CRC21 tokens
+func GetTokenSuppliesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-supplies",
+		Short: "Checks the supplies of the CRC21 tokens against the native coins backing them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TokenSupplies(cmd.Context(), &types.QueryTokenSuppliesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBridgeStatusCmd queries if the bridge flow is enabled
+func GetBridgeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-status",
+		Short: "Gets if the bridge flow is enabled, and the bridge flows turned off",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			direction, denom, channelID, err := parseBridgeSwitchFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BridgeStatus(cmd.Context(), &types.QueryBridgeStatusRequest{
+				Direction: direction,
+				Denom:     denom,
+				ChannelId: channelID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addBridgeSwitchFlags(cmd)
+	return cmd
+}
+
+// GetRateLimitsCmd queries the rate limits of ibc transfers and the current usages
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Gets the rate limits of ibc transfers and the usages in current windows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			channelID, err := cmd.Flags().GetString(FlagChannelID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{
+				Denom:     denom,
+				ChannelId: channelID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Filter by denom")
+	cmd.Flags().String(FlagChannelID, "", "Filter by ibc channel")
+	return cmd
+}
+
+// GetLogHandlersCmd queries the log handlers registered through governance
+func GetLogHandlersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-handlers [contract]",
+		Short: "Gets the log handlers registered through governance, optionally of a single contract",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLogHandlersRequest{}
+			if len(args) > 0 {
+				req.Contract = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LogHandlers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFailedLogsCmd queries the evm logs in the retry queue
+func GetFailedLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-logs [tx-hash]",
+		Short: "Gets the evm logs of which the native actions failed and are retried, optionally of a single tx",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedLogsRequest{}
+			if len(args) > 0 {
+				req.TxHash = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FailedLogs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenMappingsCmd queries all the token mappings with the token metadata
+func GetTokenMappingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-mappings",
+		Short: "Gets all the token mappings with the token metadata",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TokenMappings(cmd.Context(), &types.QueryTokenMappingsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-mappings")
 	return cmd
 }
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -24,6 +25,7 @@ import (
 	icagenesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
@@ -42,11 +44,17 @@ func GetTxCmd() *cobra.Command {
 	// this line is used by starport scaffolding # 1
 
 	cmd.AddCommand(CmdConvertTokens())
+	cmd.AddCommand(CmdConvertTokensBatch())
 	cmd.AddCommand(CmdSendToCryptoOrg())
 	cmd.AddCommand(CmdUpdateTokenMapping())
 	cmd.AddCommand(CmdTurnBridge())
 	cmd.AddCommand(CmdUpdatePermissions())
 	cmd.AddCommand(CmdStoreBlockList())
+	cmd.AddCommand(CmdSetRole())
+	cmd.AddCommand(CmdDeleteRole())
+	cmd.AddCommand(CmdGrantRole())
+	cmd.AddCommand(CmdRevokeRole())
+	cmd.AddCommand(CmdMigrateTokenBalances())
 	cmd.AddCommand(MigrateGenesisCmd())
 	return cmd
 }
@@ -85,6 +93,59 @@ func CmdConvertTokens() *cobra.Command {
 	return cmd
 }
 
+// CmdConvertTokensBatch returns a CLI command handler for converting ibc vouchers for many recipients
+func CmdConvertTokensBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-vouchers-batch [recipients-file]",
+		Short: "Convert ibc vouchers of the sender to cronos tokens of many recipients atomically",
+		Long: `Convert ibc vouchers of the sender to cronos tokens of many recipients atomically,
+each line of the recipients file is a hex address and the amount separated by space, for example:
+
+0x0000000000000000000000000000000000000001 100ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865
+0x0000000000000000000000000000000000000002 200ibc/6B5A664BF0AF4F71B2F0BAA33141E2F1321242FBD5D19762F541EC971ACB0865
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var recipients []types.VouchersRecipient
+			for i, line := range strings.Split(string(bz), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) == 0 {
+					continue
+				}
+				if len(fields) != 2 {
+					return fmt.Errorf("invalid recipient at line %d: %s", i+1, line)
+				}
+				coins, err := sdk.ParseCoinsNormalized(fields[1])
+				if err != nil {
+					return fmt.Errorf("invalid amount at line %d: %w", i+1, err)
+				}
+				recipients = append(recipients, types.VouchersRecipient{
+					Address: fields[0],
+					Coins:   coins,
+				})
+			}
+
+			msg := types.NewMsgConvertVouchersBatch(clientCtx.GetFromAddress().String(), recipients)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSendToCryptoOrg() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "transfer-tokens [from] [to] [amount]",
@@ -107,7 +168,26 @@ func CmdSendToCryptoOrg() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgTransferTokens(clientCtx.GetFromAddress().String(), argsTo, coins)
+			channelID, err := cmd.Flags().GetString(FlagChannelID)
+			if err != nil {
+				return err
+			}
+			timeoutHeightStr, err := cmd.Flags().GetString(FlagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+			timeoutTimestamp, err := cmd.Flags().GetUint64(FlagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokensWithChannel(
+				clientCtx.GetFromAddress().String(), argsTo, coins, channelID, timeoutHeight, timeoutTimestamp,
+			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -116,10 +196,19 @@ func CmdSendToCryptoOrg() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagChannelID, "", "The source channel of the ibc transfer, default to the source channel of the tokens")
+	cmd.Flags().String(FlagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}, zero means disabled")
+	cmd.Flags().Uint64(FlagPacketTimeoutTimestamp, 0, "Packet timeout timestamp in nanoseconds since unix epoch, if both timeouts are zero, it's derived from the ibc_timeout parameter")
 
 	return cmd
 }
 
+// CmdSendToCryptoOrg flags
+const (
+	FlagPacketTimeoutHeight    = "packet-timeout-height"
+	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+)
+
 // TokenMappingChangeProposalTxCmd flags
 const (
 	FlagSymbol   = "symbol"
@@ -263,11 +352,18 @@ func CmdUpdateTokenMapping() *cobra.Command {
 	return cmd
 }
 
+// CmdTurnBridge flags
+const (
+	FlagDirection = "direction"
+	FlagDenom     = "denom"
+	FlagChannelID = "channel-id"
+)
+
 // CmdTurnBridge returns a CLI command handler for enable or disable the bridge
 func CmdTurnBridge() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "turn-bridge [true/false]",
-		Short: "Turn Bridge",
+		Short: "Turn on or off the bridge flows, optionally limited to a direction, denom or channel",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -279,7 +375,11 @@ func CmdTurnBridge() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgTurnBridge(clientCtx.GetFromAddress().String(), enable)
+			direction, denom, channelID, err := parseBridgeSwitchFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTurnBridge(clientCtx.GetFromAddress().String(), enable, direction, denom, channelID)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -288,14 +388,41 @@ func CmdTurnBridge() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addBridgeSwitchFlags(cmd)
 	return cmd
 }
 
+func addBridgeSwitchFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDirection, "both", "The direction of bridge flows: inbound, outbound or both")
+	cmd.Flags().String(FlagDenom, "", "Limit to a single denom, default to all denoms")
+	cmd.Flags().String(FlagChannelID, "", "Limit to a single ibc channel, default to all channels")
+}
+
+func parseBridgeSwitchFlags(cmd *cobra.Command) (types.BridgeDirection, string, string, error) {
+	directionStr, err := cmd.Flags().GetString(FlagDirection)
+	if err != nil {
+		return 0, "", "", err
+	}
+	direction, err := types.ParseBridgeDirection(directionStr)
+	if err != nil {
+		return 0, "", "", err
+	}
+	denom, err := cmd.Flags().GetString(FlagDenom)
+	if err != nil {
+		return 0, "", "", err
+	}
+	channelID, err := cmd.Flags().GetString(FlagChannelID)
+	if err != nil {
+		return 0, "", "", err
+	}
+	return direction, denom, channelID, nil
+}
+
 // CmdUpdatePermissions returns a CLI command handler for updating cronos permissions
 func CmdUpdatePermissions() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-permissions [address] [permissions]",
-		Short: "Update Permissions, permission value: 1=CanChangeTokenMapping, 2:=CanTurnBridge, 3=All",
+		Short: "Update Permissions, permission value: 1=CanChangeTokenMapping, 2:=CanTurnBridge, 3=All, mapped to the built-in roles",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -440,3 +567,145 @@ $ %s migrate v1.0 /path/to/genesis.json --chain-id=cronos_777-1 --genesis-time=2
 
 	return cmd
 }
+
+// CmdSetRole returns a CLI command handler for creating or updating a custom role
+func CmdSetRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-role [name] [msg-types]",
+		Short: "Create or update a custom role, msg-types is a comma separated list of message type urls, like /cronos.MsgTurnBridge",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRole(clientCtx.GetFromAddress().String(), types.Role{
+				Name:     args[0],
+				MsgTypes: strings.Split(args[1], ","),
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDeleteRole returns a CLI command handler for deleting a custom role
+func CmdDeleteRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-role [name]",
+		Short: "Delete a custom role and revoke it from all the holders",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteRole(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGrantRole flags
+const (
+	FlagExpiration = "expiration"
+)
+
+// CmdGrantRole returns a CLI command handler for granting a role to an account
+func CmdGrantRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [address] [role]",
+		Short: "Grant a role to an account, optionally expires at a time",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			if expirationStr != "" {
+				t, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+				expiration = &t
+			}
+
+			msg := types.NewMsgGrantRole(clientCtx.GetFromAddress().String(), args[0], args[1], expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The expiration time of the grant in RFC3339 format, never expires if empty")
+	return cmd
+}
+
+// CmdRevokeRole returns a CLI command handler for revoking a role from an account
+func CmdRevokeRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [address] [role]",
+		Short: "Revoke a role from an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdMigrateTokenBalances returns a CLI command handler for migrating the balances of a migrated contract
+func CmdMigrateTokenBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-token-balances [contract] [holders]",
+		Short: "Migrate the balances of the holders from a migrated contract to the current contract of the denom, holders is a comma separated list of hex addresses",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateTokenBalances(clientCtx.GetFromAddress().String(), args[0], strings.Split(args[1], ","))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
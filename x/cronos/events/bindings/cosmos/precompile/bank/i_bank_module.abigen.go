@@ -31,7 +31,7 @@ var (
 
 // BankModuleMetaData contains all meta data concerning the BankModule contract.
 var BankModuleMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
 }
 
 // BankModuleABI is the input ABI used to generate the binding from.
@@ -180,6 +180,37 @@ func (_BankModule *BankModuleTransactorRaw) Transact(opts *bind.TransactOpts, me
 	return _BankModule.Contract.contract.Transact(opts, method, params...)
 }
 
+// Allowance is a free data retrieval call binding the contract method 0x927da105.
+//
+// Solidity: function allowance(address token, address owner, address spender) view returns(uint256)
+func (_BankModule *BankModuleCaller) Allowance(opts *bind.CallOpts, token common.Address, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BankModule.contract.Call(opts, &out, "allowance", token, owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0x927da105.
+//
+// Solidity: function allowance(address token, address owner, address spender) view returns(uint256)
+func (_BankModule *BankModuleSession) Allowance(token common.Address, owner common.Address, spender common.Address) (*big.Int, error) {
+	return _BankModule.Contract.Allowance(&_BankModule.CallOpts, token, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0x927da105.
+//
+// Solidity: function allowance(address token, address owner, address spender) view returns(uint256)
+func (_BankModule *BankModuleCallerSession) Allowance(token common.Address, owner common.Address, spender common.Address) (*big.Int, error) {
+	return _BankModule.Contract.Allowance(&_BankModule.CallOpts, token, owner, spender)
+}
+
 // BalanceOf is a free data retrieval call binding the contract method 0xf7888aec.
 //
 // Solidity: function balanceOf(address , address ) view returns(uint256)
@@ -211,6 +242,27 @@ func (_BankModule *BankModuleCallerSession) BalanceOf(arg0 common.Address, arg1
 	return _BankModule.Contract.BalanceOf(&_BankModule.CallOpts, arg0, arg1)
 }
 
+// Approve is a paid mutator transaction binding the contract method 0xe1f21c67.
+//
+// Solidity: function approve(address token, address spender, uint256 amount) payable returns(bool)
+func (_BankModule *BankModuleTransactor) Approve(opts *bind.TransactOpts, token common.Address, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankModule.contract.Transact(opts, "approve", token, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0xe1f21c67.
+//
+// Solidity: function approve(address token, address spender, uint256 amount) payable returns(bool)
+func (_BankModule *BankModuleSession) Approve(token common.Address, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankModule.Contract.Approve(&_BankModule.TransactOpts, token, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0xe1f21c67.
+//
+// Solidity: function approve(address token, address spender, uint256 amount) payable returns(bool)
+func (_BankModule *BankModuleTransactorSession) Approve(token common.Address, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankModule.Contract.Approve(&_BankModule.TransactOpts, token, spender, amount)
+}
+
 // Burn is a paid mutator transaction binding the contract method 0x9dc29fac.
 //
 // Solidity: function burn(address , uint256 ) payable returns(bool)
@@ -273,3 +325,24 @@ func (_BankModule *BankModuleSession) Transfer(arg0 common.Address, arg1 common.
 func (_BankModule *BankModuleTransactorSession) Transfer(arg0 common.Address, arg1 common.Address, arg2 *big.Int) (*types.Transaction, error) {
 	return _BankModule.Contract.Transfer(&_BankModule.TransactOpts, arg0, arg1, arg2)
 }
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x15dacbea.
+//
+// Solidity: function transferFrom(address token, address from, address to, uint256 amount) payable returns(bool)
+func (_BankModule *BankModuleTransactor) TransferFrom(opts *bind.TransactOpts, token common.Address, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankModule.contract.Transact(opts, "transferFrom", token, from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x15dacbea.
+//
+// Solidity: function transferFrom(address token, address from, address to, uint256 amount) payable returns(bool)
+func (_BankModule *BankModuleSession) TransferFrom(token common.Address, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankModule.Contract.TransferFrom(&_BankModule.TransactOpts, token, from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x15dacbea.
+//
+// Solidity: function transferFrom(address token, address from, address to, uint256 amount) payable returns(bool)
+func (_BankModule *BankModuleTransactorSession) TransferFrom(token common.Address, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _BankModule.Contract.TransferFrom(&_BankModule.TransactOpts, token, from, to, amount)
+}
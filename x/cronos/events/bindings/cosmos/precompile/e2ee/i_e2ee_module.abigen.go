@@ -0,0 +1,264 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package e2ee
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// E2EEModuleMetaData contains all meta data concerning the E2EEModule contract.
+var E2EEModuleMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"}],\"name\":\"registerEncryptionKey\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"key\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"}],\"name\":\"keys\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// E2EEModuleABI is the input ABI used to generate the binding from.
+// Deprecated: Use E2EEModuleMetaData.ABI instead.
+var E2EEModuleABI = E2EEModuleMetaData.ABI
+
+// E2EEModule is an auto generated Go binding around an Ethereum contract.
+type E2EEModule struct {
+	E2EEModuleCaller     // Read-only binding to the contract
+	E2EEModuleTransactor // Write-only binding to the contract
+	E2EEModuleFilterer   // Log filterer for contract events
+}
+
+// E2EEModuleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type E2EEModuleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// E2EEModuleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type E2EEModuleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// E2EEModuleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type E2EEModuleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// E2EEModuleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type E2EEModuleSession struct {
+	Contract     *E2EEModule       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// E2EEModuleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type E2EEModuleCallerSession struct {
+	Contract *E2EEModuleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// E2EEModuleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type E2EEModuleTransactorSession struct {
+	Contract     *E2EEModuleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// E2EEModuleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type E2EEModuleRaw struct {
+	Contract *E2EEModule // Generic contract binding to access the raw methods on
+}
+
+// E2EEModuleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type E2EEModuleCallerRaw struct {
+	Contract *E2EEModuleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// E2EEModuleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type E2EEModuleTransactorRaw struct {
+	Contract *E2EEModuleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewE2EEModule creates a new instance of E2EEModule, bound to a specific deployed contract.
+func NewE2EEModule(address common.Address, backend bind.ContractBackend) (*E2EEModule, error) {
+	contract, err := bindE2EEModule(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &E2EEModule{E2EEModuleCaller: E2EEModuleCaller{contract: contract}, E2EEModuleTransactor: E2EEModuleTransactor{contract: contract}, E2EEModuleFilterer: E2EEModuleFilterer{contract: contract}}, nil
+}
+
+// NewE2EEModuleCaller creates a new read-only instance of E2EEModule, bound to a specific deployed contract.
+func NewE2EEModuleCaller(address common.Address, caller bind.ContractCaller) (*E2EEModuleCaller, error) {
+	contract, err := bindE2EEModule(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &E2EEModuleCaller{contract: contract}, nil
+}
+
+// NewE2EEModuleTransactor creates a new write-only instance of E2EEModule, bound to a specific deployed contract.
+func NewE2EEModuleTransactor(address common.Address, transactor bind.ContractTransactor) (*E2EEModuleTransactor, error) {
+	contract, err := bindE2EEModule(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &E2EEModuleTransactor{contract: contract}, nil
+}
+
+// NewE2EEModuleFilterer creates a new log filterer instance of E2EEModule, bound to a specific deployed contract.
+func NewE2EEModuleFilterer(address common.Address, filterer bind.ContractFilterer) (*E2EEModuleFilterer, error) {
+	contract, err := bindE2EEModule(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &E2EEModuleFilterer{contract: contract}, nil
+}
+
+// bindE2EEModule binds a generic wrapper to an already deployed contract.
+func bindE2EEModule(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := E2EEModuleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_E2EEModule *E2EEModuleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _E2EEModule.Contract.E2EEModuleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_E2EEModule *E2EEModuleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _E2EEModule.Contract.E2EEModuleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_E2EEModule *E2EEModuleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _E2EEModule.Contract.E2EEModuleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_E2EEModule *E2EEModuleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _E2EEModule.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_E2EEModule *E2EEModuleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _E2EEModule.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_E2EEModule *E2EEModuleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _E2EEModule.Contract.contract.Transact(opts, method, params...)
+}
+
+// Key is a free data retrieval call binding the contract method 0x06e96bfb.
+//
+// Solidity: function key(address addr) view returns(string)
+func (_E2EEModule *E2EEModuleCaller) Key(opts *bind.CallOpts, addr common.Address) (string, error) {
+	var out []interface{}
+	err := _E2EEModule.contract.Call(opts, &out, "key", addr)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Key is a free data retrieval call binding the contract method 0x06e96bfb.
+//
+// Solidity: function key(address addr) view returns(string)
+func (_E2EEModule *E2EEModuleSession) Key(addr common.Address) (string, error) {
+	return _E2EEModule.Contract.Key(&_E2EEModule.CallOpts, addr)
+}
+
+// Key is a free data retrieval call binding the contract method 0x06e96bfb.
+//
+// Solidity: function key(address addr) view returns(string)
+func (_E2EEModule *E2EEModuleCallerSession) Key(addr common.Address) (string, error) {
+	return _E2EEModule.Contract.Key(&_E2EEModule.CallOpts, addr)
+}
+
+// Keys is a free data retrieval call binding the contract method 0xce8e6837.
+//
+// Solidity: function keys(address[] addrs) view returns(string[])
+func (_E2EEModule *E2EEModuleCaller) Keys(opts *bind.CallOpts, addrs []common.Address) ([]string, error) {
+	var out []interface{}
+	err := _E2EEModule.contract.Call(opts, &out, "keys", addrs)
+
+	if err != nil {
+		return *new([]string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]string)).(*[]string)
+
+	return out0, err
+
+}
+
+// Keys is a free data retrieval call binding the contract method 0xce8e6837.
+//
+// Solidity: function keys(address[] addrs) view returns(string[])
+func (_E2EEModule *E2EEModuleSession) Keys(addrs []common.Address) ([]string, error) {
+	return _E2EEModule.Contract.Keys(&_E2EEModule.CallOpts, addrs)
+}
+
+// Keys is a free data retrieval call binding the contract method 0xce8e6837.
+//
+// Solidity: function keys(address[] addrs) view returns(string[])
+func (_E2EEModule *E2EEModuleCallerSession) Keys(addrs []common.Address) ([]string, error) {
+	return _E2EEModule.Contract.Keys(&_E2EEModule.CallOpts, addrs)
+}
+
+// RegisterEncryptionKey is a paid mutator transaction binding the contract method 0x8207767c.
+//
+// Solidity: function registerEncryptionKey(string key) payable returns(bool)
+func (_E2EEModule *E2EEModuleTransactor) RegisterEncryptionKey(opts *bind.TransactOpts, key string) (*types.Transaction, error) {
+	return _E2EEModule.contract.Transact(opts, "registerEncryptionKey", key)
+}
+
+// RegisterEncryptionKey is a paid mutator transaction binding the contract method 0x8207767c.
+//
+// Solidity: function registerEncryptionKey(string key) payable returns(bool)
+func (_E2EEModule *E2EEModuleSession) RegisterEncryptionKey(key string) (*types.Transaction, error) {
+	return _E2EEModule.Contract.RegisterEncryptionKey(&_E2EEModule.TransactOpts, key)
+}
+
+// RegisterEncryptionKey is a paid mutator transaction binding the contract method 0x8207767c.
+//
+// Solidity: function registerEncryptionKey(string key) payable returns(bool)
+func (_E2EEModule *E2EEModuleTransactorSession) RegisterEncryptionKey(key string) (*types.Transaction, error) {
+	return _E2EEModule.Contract.RegisterEncryptionKey(&_E2EEModule.TransactOpts, key)
+}
@@ -0,0 +1,348 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package exec
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ExecModuleMetaData contains all meta data concerning the ExecModule contract.
+var ExecModuleMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"eventType\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"keys\",\"type\":\"string[]\"},{\"indexed\":false,\"internalType\":\"string[]\",\"name\":\"values\",\"type\":\"string[]\"}],\"name\":\"CosmosEvent\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes[]\",\"name\":\"msgs\",\"type\":\"bytes[]\"}],\"name\":\"exec\",\"outputs\":[{\"internalType\":\"bytes[]\",\"name\":\"\",\"type\":\"bytes[]\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// ExecModuleABI is the input ABI used to generate the binding from.
+// Deprecated: Use ExecModuleMetaData.ABI instead.
+var ExecModuleABI = ExecModuleMetaData.ABI
+
+// ExecModule is an auto generated Go binding around an Ethereum contract.
+type ExecModule struct {
+	ExecModuleCaller     // Read-only binding to the contract
+	ExecModuleTransactor // Write-only binding to the contract
+	ExecModuleFilterer   // Log filterer for contract events
+}
+
+// ExecModuleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ExecModuleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExecModuleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ExecModuleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExecModuleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ExecModuleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExecModuleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ExecModuleSession struct {
+	Contract     *ExecModule       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ExecModuleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ExecModuleCallerSession struct {
+	Contract *ExecModuleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// ExecModuleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ExecModuleTransactorSession struct {
+	Contract     *ExecModuleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// ExecModuleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ExecModuleRaw struct {
+	Contract *ExecModule // Generic contract binding to access the raw methods on
+}
+
+// ExecModuleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ExecModuleCallerRaw struct {
+	Contract *ExecModuleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ExecModuleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ExecModuleTransactorRaw struct {
+	Contract *ExecModuleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewExecModule creates a new instance of ExecModule, bound to a specific deployed contract.
+func NewExecModule(address common.Address, backend bind.ContractBackend) (*ExecModule, error) {
+	contract, err := bindExecModule(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecModule{ExecModuleCaller: ExecModuleCaller{contract: contract}, ExecModuleTransactor: ExecModuleTransactor{contract: contract}, ExecModuleFilterer: ExecModuleFilterer{contract: contract}}, nil
+}
+
+// NewExecModuleCaller creates a new read-only instance of ExecModule, bound to a specific deployed contract.
+func NewExecModuleCaller(address common.Address, caller bind.ContractCaller) (*ExecModuleCaller, error) {
+	contract, err := bindExecModule(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecModuleCaller{contract: contract}, nil
+}
+
+// NewExecModuleTransactor creates a new write-only instance of ExecModule, bound to a specific deployed contract.
+func NewExecModuleTransactor(address common.Address, transactor bind.ContractTransactor) (*ExecModuleTransactor, error) {
+	contract, err := bindExecModule(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecModuleTransactor{contract: contract}, nil
+}
+
+// NewExecModuleFilterer creates a new log filterer instance of ExecModule, bound to a specific deployed contract.
+func NewExecModuleFilterer(address common.Address, filterer bind.ContractFilterer) (*ExecModuleFilterer, error) {
+	contract, err := bindExecModule(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecModuleFilterer{contract: contract}, nil
+}
+
+// bindExecModule binds a generic wrapper to an already deployed contract.
+func bindExecModule(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ExecModuleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExecModule *ExecModuleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ExecModule.Contract.ExecModuleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExecModule *ExecModuleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExecModule.Contract.ExecModuleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExecModule *ExecModuleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExecModule.Contract.ExecModuleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExecModule *ExecModuleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ExecModule.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExecModule *ExecModuleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExecModule.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExecModule *ExecModuleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExecModule.Contract.contract.Transact(opts, method, params...)
+}
+
+// Exec is a paid mutator transaction binding the contract method 0xaa35f553.
+//
+// Solidity: function exec(bytes[] msgs) payable returns(bytes[])
+func (_ExecModule *ExecModuleTransactor) Exec(opts *bind.TransactOpts, msgs [][]byte) (*types.Transaction, error) {
+	return _ExecModule.contract.Transact(opts, "exec", msgs)
+}
+
+// Exec is a paid mutator transaction binding the contract method 0xaa35f553.
+//
+// Solidity: function exec(bytes[] msgs) payable returns(bytes[])
+func (_ExecModule *ExecModuleSession) Exec(msgs [][]byte) (*types.Transaction, error) {
+	return _ExecModule.Contract.Exec(&_ExecModule.TransactOpts, msgs)
+}
+
+// Exec is a paid mutator transaction binding the contract method 0xaa35f553.
+//
+// Solidity: function exec(bytes[] msgs) payable returns(bytes[])
+func (_ExecModule *ExecModuleTransactorSession) Exec(msgs [][]byte) (*types.Transaction, error) {
+	return _ExecModule.Contract.Exec(&_ExecModule.TransactOpts, msgs)
+}
+
+// ExecModuleCosmosEventIterator is returned from FilterCosmosEvent and is used to iterate over the raw logs and unpacked data for CosmosEvent events raised by the ExecModule contract.
+type ExecModuleCosmosEventIterator struct {
+	Event *ExecModuleCosmosEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ExecModuleCosmosEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ExecModuleCosmosEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ExecModuleCosmosEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ExecModuleCosmosEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ExecModuleCosmosEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ExecModuleCosmosEvent represents a CosmosEvent event raised by the ExecModule contract.
+type ExecModuleCosmosEvent struct {
+	EventType common.Hash
+	Keys      []string
+	Values    []string
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterCosmosEvent is a free log retrieval operation binding the contract event 0xc36e3c21a9ca82942bc203e4bcae786fe9d92128e977b3f99f3cf361efbc2569.
+//
+// Solidity: event CosmosEvent(string indexed eventType, string[] keys, string[] values)
+func (_ExecModule *ExecModuleFilterer) FilterCosmosEvent(opts *bind.FilterOpts, eventType []string) (*ExecModuleCosmosEventIterator, error) {
+
+	var eventTypeRule []interface{}
+	for _, eventTypeItem := range eventType {
+		eventTypeRule = append(eventTypeRule, eventTypeItem)
+	}
+
+	logs, sub, err := _ExecModule.contract.FilterLogs(opts, "CosmosEvent", eventTypeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecModuleCosmosEventIterator{contract: _ExecModule.contract, event: "CosmosEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchCosmosEvent is a free log subscription operation binding the contract event 0xc36e3c21a9ca82942bc203e4bcae786fe9d92128e977b3f99f3cf361efbc2569.
+//
+// Solidity: event CosmosEvent(string indexed eventType, string[] keys, string[] values)
+func (_ExecModule *ExecModuleFilterer) WatchCosmosEvent(opts *bind.WatchOpts, sink chan<- *ExecModuleCosmosEvent, eventType []string) (event.Subscription, error) {
+
+	var eventTypeRule []interface{}
+	for _, eventTypeItem := range eventType {
+		eventTypeRule = append(eventTypeRule, eventTypeItem)
+	}
+
+	logs, sub, err := _ExecModule.contract.WatchLogs(opts, "CosmosEvent", eventTypeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ExecModuleCosmosEvent)
+				if err := _ExecModule.contract.UnpackLog(event, "CosmosEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCosmosEvent is a log parse operation binding the contract event 0xc36e3c21a9ca82942bc203e4bcae786fe9d92128e977b3f99f3cf361efbc2569.
+//
+// Solidity: event CosmosEvent(string indexed eventType, string[] keys, string[] values)
+func (_ExecModule *ExecModuleFilterer) ParseCosmosEvent(log types.Log) (*ExecModuleCosmosEvent, error) {
+	event := new(ExecModuleCosmosEvent)
+	if err := _ExecModule.contract.UnpackLog(event, "CosmosEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,795 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package gov
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CosmosCoin is an auto generated low-level Go binding around an user-defined struct.
+type CosmosCoin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+// GovModuleMetaData contains all meta data concerning the GovModule contract.
+var GovModuleMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"depositor\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"ProposalDeposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"option\",\"type\":\"string\"}],\"name\":\"ProposalVote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"proposalProposer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"proposalMessages\",\"type\":\"string\"}],\"name\":\"SubmitProposal\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getProposal\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"}],\"name\":\"getTallyResult\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"submitProposal\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"vote\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"voteWeighted\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// GovModuleABI is the input ABI used to generate the binding from.
+// Deprecated: Use GovModuleMetaData.ABI instead.
+var GovModuleABI = GovModuleMetaData.ABI
+
+// GovModule is an auto generated Go binding around an Ethereum contract.
+type GovModule struct {
+	GovModuleCaller     // Read-only binding to the contract
+	GovModuleTransactor // Write-only binding to the contract
+	GovModuleFilterer   // Log filterer for contract events
+}
+
+// GovModuleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovModuleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovModuleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovModuleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovModuleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovModuleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovModuleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovModuleSession struct {
+	Contract     *GovModule        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovModuleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovModuleCallerSession struct {
+	Contract *GovModuleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// GovModuleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovModuleTransactorSession struct {
+	Contract     *GovModuleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// GovModuleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovModuleRaw struct {
+	Contract *GovModule // Generic contract binding to access the raw methods on
+}
+
+// GovModuleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovModuleCallerRaw struct {
+	Contract *GovModuleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovModuleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovModuleTransactorRaw struct {
+	Contract *GovModuleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGovModule creates a new instance of GovModule, bound to a specific deployed contract.
+func NewGovModule(address common.Address, backend bind.ContractBackend) (*GovModule, error) {
+	contract, err := bindGovModule(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &GovModule{GovModuleCaller: GovModuleCaller{contract: contract}, GovModuleTransactor: GovModuleTransactor{contract: contract}, GovModuleFilterer: GovModuleFilterer{contract: contract}}, nil
+}
+
+// NewGovModuleCaller creates a new read-only instance of GovModule, bound to a specific deployed contract.
+func NewGovModuleCaller(address common.Address, caller bind.ContractCaller) (*GovModuleCaller, error) {
+	contract, err := bindGovModule(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovModuleCaller{contract: contract}, nil
+}
+
+// NewGovModuleTransactor creates a new write-only instance of GovModule, bound to a specific deployed contract.
+func NewGovModuleTransactor(address common.Address, transactor bind.ContractTransactor) (*GovModuleTransactor, error) {
+	contract, err := bindGovModule(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovModuleTransactor{contract: contract}, nil
+}
+
+// NewGovModuleFilterer creates a new log filterer instance of GovModule, bound to a specific deployed contract.
+func NewGovModuleFilterer(address common.Address, filterer bind.ContractFilterer) (*GovModuleFilterer, error) {
+	contract, err := bindGovModule(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovModuleFilterer{contract: contract}, nil
+}
+
+// bindGovModule binds a generic wrapper to an already deployed contract.
+func bindGovModule(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := GovModuleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovModule *GovModuleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GovModule.Contract.GovModuleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovModule *GovModuleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovModule.Contract.GovModuleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovModule *GovModuleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovModule.Contract.GovModuleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovModule *GovModuleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GovModule.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovModule *GovModuleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovModule.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovModule *GovModuleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovModule.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 proposalId) view returns(bytes)
+func (_GovModule *GovModuleCaller) GetProposal(opts *bind.CallOpts, proposalId uint64) ([]byte, error) {
+	var out []interface{}
+	err := _GovModule.contract.Call(opts, &out, "getProposal", proposalId)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 proposalId) view returns(bytes)
+func (_GovModule *GovModuleSession) GetProposal(proposalId uint64) ([]byte, error) {
+	return _GovModule.Contract.GetProposal(&_GovModule.CallOpts, proposalId)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 proposalId) view returns(bytes)
+func (_GovModule *GovModuleCallerSession) GetProposal(proposalId uint64) ([]byte, error) {
+	return _GovModule.Contract.GetProposal(&_GovModule.CallOpts, proposalId)
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 proposalId) view returns(bytes)
+func (_GovModule *GovModuleCaller) GetTallyResult(opts *bind.CallOpts, proposalId uint64) ([]byte, error) {
+	var out []interface{}
+	err := _GovModule.contract.Call(opts, &out, "getTallyResult", proposalId)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 proposalId) view returns(bytes)
+func (_GovModule *GovModuleSession) GetTallyResult(proposalId uint64) ([]byte, error) {
+	return _GovModule.Contract.GetTallyResult(&_GovModule.CallOpts, proposalId)
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 proposalId) view returns(bytes)
+func (_GovModule *GovModuleCallerSession) GetTallyResult(proposalId uint64) ([]byte, error) {
+	return _GovModule.Contract.GetTallyResult(&_GovModule.CallOpts, proposalId)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x98b1e06a.
+//
+// Solidity: function deposit(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactor) Deposit(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _GovModule.contract.Transact(opts, "deposit", data)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x98b1e06a.
+//
+// Solidity: function deposit(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleSession) Deposit(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.Deposit(&_GovModule.TransactOpts, data)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x98b1e06a.
+//
+// Solidity: function deposit(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactorSession) Deposit(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.Deposit(&_GovModule.TransactOpts, data)
+}
+
+// SubmitProposal is a paid mutator transaction binding the contract method 0xd2383136.
+//
+// Solidity: function submitProposal(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactor) SubmitProposal(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _GovModule.contract.Transact(opts, "submitProposal", data)
+}
+
+// SubmitProposal is a paid mutator transaction binding the contract method 0xd2383136.
+//
+// Solidity: function submitProposal(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleSession) SubmitProposal(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.SubmitProposal(&_GovModule.TransactOpts, data)
+}
+
+// SubmitProposal is a paid mutator transaction binding the contract method 0xd2383136.
+//
+// Solidity: function submitProposal(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactorSession) SubmitProposal(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.SubmitProposal(&_GovModule.TransactOpts, data)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xe9dc0614.
+//
+// Solidity: function vote(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactor) Vote(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _GovModule.contract.Transact(opts, "vote", data)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xe9dc0614.
+//
+// Solidity: function vote(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleSession) Vote(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.Vote(&_GovModule.TransactOpts, data)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xe9dc0614.
+//
+// Solidity: function vote(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactorSession) Vote(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.Vote(&_GovModule.TransactOpts, data)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0xb84d076d.
+//
+// Solidity: function voteWeighted(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactor) VoteWeighted(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _GovModule.contract.Transact(opts, "voteWeighted", data)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0xb84d076d.
+//
+// Solidity: function voteWeighted(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleSession) VoteWeighted(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.VoteWeighted(&_GovModule.TransactOpts, data)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0xb84d076d.
+//
+// Solidity: function voteWeighted(bytes data) payable returns(bytes)
+func (_GovModule *GovModuleTransactorSession) VoteWeighted(data []byte) (*types.Transaction, error) {
+	return _GovModule.Contract.VoteWeighted(&_GovModule.TransactOpts, data)
+}
+
+// GovModuleProposalDepositIterator is returned from FilterProposalDeposit and is used to iterate over the raw logs and unpacked data for ProposalDeposit events raised by the GovModule contract.
+type GovModuleProposalDepositIterator struct {
+	Event *GovModuleProposalDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovModuleProposalDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovModuleProposalDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovModuleProposalDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovModuleProposalDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovModuleProposalDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovModuleProposalDeposit represents a ProposalDeposit event raised by the GovModule contract.
+type GovModuleProposalDeposit struct {
+	ProposalId uint64
+	Depositor  common.Address
+	Amount     []CosmosCoin
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalDeposit is a free log retrieval operation binding the contract event 0xfcaf78eb160698fd5982331d11b25e828b39f88b3e3238b56a9f8603bd400b0f.
+//
+// Solidity: event ProposalDeposit(uint64 indexed proposalId, address indexed depositor, (uint256,string)[] amount)
+func (_GovModule *GovModuleFilterer) FilterProposalDeposit(opts *bind.FilterOpts, proposalId []uint64, depositor []common.Address) (*GovModuleProposalDepositIterator, error) {
+
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+	var depositorRule []interface{}
+	for _, depositorItem := range depositor {
+		depositorRule = append(depositorRule, depositorItem)
+	}
+
+	logs, sub, err := _GovModule.contract.FilterLogs(opts, "ProposalDeposit", proposalIdRule, depositorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovModuleProposalDepositIterator{contract: _GovModule.contract, event: "ProposalDeposit", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalDeposit is a free log subscription operation binding the contract event 0xfcaf78eb160698fd5982331d11b25e828b39f88b3e3238b56a9f8603bd400b0f.
+//
+// Solidity: event ProposalDeposit(uint64 indexed proposalId, address indexed depositor, (uint256,string)[] amount)
+func (_GovModule *GovModuleFilterer) WatchProposalDeposit(opts *bind.WatchOpts, sink chan<- *GovModuleProposalDeposit, proposalId []uint64, depositor []common.Address) (event.Subscription, error) {
+
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+	var depositorRule []interface{}
+	for _, depositorItem := range depositor {
+		depositorRule = append(depositorRule, depositorItem)
+	}
+
+	logs, sub, err := _GovModule.contract.WatchLogs(opts, "ProposalDeposit", proposalIdRule, depositorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovModuleProposalDeposit)
+				if err := _GovModule.contract.UnpackLog(event, "ProposalDeposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalDeposit is a log parse operation binding the contract event 0xfcaf78eb160698fd5982331d11b25e828b39f88b3e3238b56a9f8603bd400b0f.
+//
+// Solidity: event ProposalDeposit(uint64 indexed proposalId, address indexed depositor, (uint256,string)[] amount)
+func (_GovModule *GovModuleFilterer) ParseProposalDeposit(log types.Log) (*GovModuleProposalDeposit, error) {
+	event := new(GovModuleProposalDeposit)
+	if err := _GovModule.contract.UnpackLog(event, "ProposalDeposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovModuleProposalVoteIterator is returned from FilterProposalVote and is used to iterate over the raw logs and unpacked data for ProposalVote events raised by the GovModule contract.
+type GovModuleProposalVoteIterator struct {
+	Event *GovModuleProposalVote // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovModuleProposalVoteIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovModuleProposalVote)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovModuleProposalVote)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovModuleProposalVoteIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovModuleProposalVoteIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovModuleProposalVote represents a ProposalVote event raised by the GovModule contract.
+type GovModuleProposalVote struct {
+	ProposalId uint64
+	Voter      common.Address
+	Option     string
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalVote is a free log retrieval operation binding the contract event 0xbf17c52ab5b3c03ea6177a90800d5132f47686fb644e7504253e20f82b44546a.
+//
+// Solidity: event ProposalVote(uint64 indexed proposalId, address indexed voter, string option)
+func (_GovModule *GovModuleFilterer) FilterProposalVote(opts *bind.FilterOpts, proposalId []uint64, voter []common.Address) (*GovModuleProposalVoteIterator, error) {
+
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovModule.contract.FilterLogs(opts, "ProposalVote", proposalIdRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovModuleProposalVoteIterator{contract: _GovModule.contract, event: "ProposalVote", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalVote is a free log subscription operation binding the contract event 0xbf17c52ab5b3c03ea6177a90800d5132f47686fb644e7504253e20f82b44546a.
+//
+// Solidity: event ProposalVote(uint64 indexed proposalId, address indexed voter, string option)
+func (_GovModule *GovModuleFilterer) WatchProposalVote(opts *bind.WatchOpts, sink chan<- *GovModuleProposalVote, proposalId []uint64, voter []common.Address) (event.Subscription, error) {
+
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+
+	logs, sub, err := _GovModule.contract.WatchLogs(opts, "ProposalVote", proposalIdRule, voterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovModuleProposalVote)
+				if err := _GovModule.contract.UnpackLog(event, "ProposalVote", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalVote is a log parse operation binding the contract event 0xbf17c52ab5b3c03ea6177a90800d5132f47686fb644e7504253e20f82b44546a.
+//
+// Solidity: event ProposalVote(uint64 indexed proposalId, address indexed voter, string option)
+func (_GovModule *GovModuleFilterer) ParseProposalVote(log types.Log) (*GovModuleProposalVote, error) {
+	event := new(GovModuleProposalVote)
+	if err := _GovModule.contract.UnpackLog(event, "ProposalVote", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovModuleSubmitProposalIterator is returned from FilterSubmitProposal and is used to iterate over the raw logs and unpacked data for SubmitProposal events raised by the GovModule contract.
+type GovModuleSubmitProposalIterator struct {
+	Event *GovModuleSubmitProposal // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovModuleSubmitProposalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovModuleSubmitProposal)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovModuleSubmitProposal)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovModuleSubmitProposalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovModuleSubmitProposalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovModuleSubmitProposal represents a SubmitProposal event raised by the GovModule contract.
+type GovModuleSubmitProposal struct {
+	ProposalId       uint64
+	ProposalProposer common.Address
+	ProposalMessages string
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterSubmitProposal is a free log retrieval operation binding the contract event 0x1dc0c652ac886fb3cdc82c67b8590bdbd81a5df53f404ea7446a281bb36fae57.
+//
+// Solidity: event SubmitProposal(uint64 indexed proposalId, address indexed proposalProposer, string proposalMessages)
+func (_GovModule *GovModuleFilterer) FilterSubmitProposal(opts *bind.FilterOpts, proposalId []uint64, proposalProposer []common.Address) (*GovModuleSubmitProposalIterator, error) {
+
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+	var proposalProposerRule []interface{}
+	for _, proposalProposerItem := range proposalProposer {
+		proposalProposerRule = append(proposalProposerRule, proposalProposerItem)
+	}
+
+	logs, sub, err := _GovModule.contract.FilterLogs(opts, "SubmitProposal", proposalIdRule, proposalProposerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovModuleSubmitProposalIterator{contract: _GovModule.contract, event: "SubmitProposal", logs: logs, sub: sub}, nil
+}
+
+// WatchSubmitProposal is a free log subscription operation binding the contract event 0x1dc0c652ac886fb3cdc82c67b8590bdbd81a5df53f404ea7446a281bb36fae57.
+//
+// Solidity: event SubmitProposal(uint64 indexed proposalId, address indexed proposalProposer, string proposalMessages)
+func (_GovModule *GovModuleFilterer) WatchSubmitProposal(opts *bind.WatchOpts, sink chan<- *GovModuleSubmitProposal, proposalId []uint64, proposalProposer []common.Address) (event.Subscription, error) {
+
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+	var proposalProposerRule []interface{}
+	for _, proposalProposerItem := range proposalProposer {
+		proposalProposerRule = append(proposalProposerRule, proposalProposerItem)
+	}
+
+	logs, sub, err := _GovModule.contract.WatchLogs(opts, "SubmitProposal", proposalIdRule, proposalProposerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovModuleSubmitProposal)
+				if err := _GovModule.contract.UnpackLog(event, "SubmitProposal", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSubmitProposal is a log parse operation binding the contract event 0x1dc0c652ac886fb3cdc82c67b8590bdbd81a5df53f404ea7446a281bb36fae57.
+//
+// Solidity: event SubmitProposal(uint64 indexed proposalId, address indexed proposalProposer, string proposalMessages)
+func (_GovModule *GovModuleFilterer) ParseSubmitProposal(log types.Log) (*GovModuleSubmitProposal, error) {
+	event := new(GovModuleSubmitProposal)
+	if err := _GovModule.contract.UnpackLog(event, "SubmitProposal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
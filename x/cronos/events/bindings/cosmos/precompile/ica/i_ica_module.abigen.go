@@ -31,7 +31,7 @@ var (
 
 // ICAModuleMetaData contains all meta data concerning the ICAModule contract.
 var ICAModuleMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"packetSrcChannel\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"SubmitMsgsResult\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"queryAccount\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"version\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"ordering\",\"type\":\"int32\"}],\"name\":\"registerAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"timeout\",\"type\":\"uint256\"}],\"name\":\"submitMsgs\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"packetSrcChannel\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"SubmitMsgsResult\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"version\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"ordering\",\"type\":\"int32\"}],\"name\":\"registerAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ownerID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"version\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"ordering\",\"type\":\"int32\"}],\"name\":\"registerAccountWithOwnerID\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ownerID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"version\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"ordering\",\"type\":\"int32\"}],\"name\":\"reopenChannel\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"queryAccount\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"ownerID\",\"type\":\"string\"}],\"name\":\"queryAccountWithOwnerID\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"ownerID\",\"type\":\"string\"}],\"name\":\"queryActiveChannel\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"channelID\",\"type\":\"string\"},{\"internalType\":\"int32\",\"name\":\"state\",\"type\":\"int32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"timeout\",\"type\":\"uint256\"}],\"name\":\"submitMsgs\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ownerID\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"timeout\",\"type\":\"uint256\"}],\"name\":\"submitMsgsWithOwnerID\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"connectionID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"ownerID\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"timeoutTimestamp\",\"type\":\"uint64\"}],\"name\":\"submitMsgsWithTimeoutTimestamp\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"result\",\"type\":\"bytes\"}],\"name\":\"decodeTxMsgResults\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"typeUrls\",\"type\":\"string[]\"},{\"internalType\":\"bytes[]\",\"name\":\"responses\",\"type\":\"bytes[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // ICAModuleABI is the input ABI used to generate the binding from.
@@ -180,6 +180,51 @@ func (_ICAModule *ICAModuleTransactorRaw) Transact(opts *bind.TransactOpts, meth
 	return _ICAModule.Contract.contract.Transact(opts, method, params...)
 }
 
+// DecodeTxMsgResults is a free data retrieval call binding the contract method 0x0edf0fbc.
+//
+// Solidity: function decodeTxMsgResults(bytes result) view returns(string[] typeUrls, bytes[] responses)
+func (_ICAModule *ICAModuleCaller) DecodeTxMsgResults(opts *bind.CallOpts, result []byte) (struct {
+	TypeUrls  []string
+	Responses [][]byte
+}, error) {
+	var out []interface{}
+	err := _ICAModule.contract.Call(opts, &out, "decodeTxMsgResults", result)
+
+	outstruct := new(struct {
+		TypeUrls  []string
+		Responses [][]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TypeUrls = *abi.ConvertType(out[0], new([]string)).(*[]string)
+	outstruct.Responses = *abi.ConvertType(out[1], new([][]byte)).(*[][]byte)
+
+	return *outstruct, err
+
+}
+
+// DecodeTxMsgResults is a free data retrieval call binding the contract method 0x0edf0fbc.
+//
+// Solidity: function decodeTxMsgResults(bytes result) view returns(string[] typeUrls, bytes[] responses)
+func (_ICAModule *ICAModuleSession) DecodeTxMsgResults(result []byte) (struct {
+	TypeUrls  []string
+	Responses [][]byte
+}, error) {
+	return _ICAModule.Contract.DecodeTxMsgResults(&_ICAModule.CallOpts, result)
+}
+
+// DecodeTxMsgResults is a free data retrieval call binding the contract method 0x0edf0fbc.
+//
+// Solidity: function decodeTxMsgResults(bytes result) view returns(string[] typeUrls, bytes[] responses)
+func (_ICAModule *ICAModuleCallerSession) DecodeTxMsgResults(result []byte) (struct {
+	TypeUrls  []string
+	Responses [][]byte
+}, error) {
+	return _ICAModule.Contract.DecodeTxMsgResults(&_ICAModule.CallOpts, result)
+}
+
 // QueryAccount is a free data retrieval call binding the contract method 0x15bf8a47.
 //
 // Solidity: function queryAccount(string connectionID, address addr) view returns(string)
@@ -211,6 +256,82 @@ func (_ICAModule *ICAModuleCallerSession) QueryAccount(connectionID string, addr
 	return _ICAModule.Contract.QueryAccount(&_ICAModule.CallOpts, connectionID, addr)
 }
 
+// QueryAccountWithOwnerID is a free data retrieval call binding the contract method 0x8f1b4695.
+//
+// Solidity: function queryAccountWithOwnerID(string connectionID, address addr, string ownerID) view returns(string)
+func (_ICAModule *ICAModuleCaller) QueryAccountWithOwnerID(opts *bind.CallOpts, connectionID string, addr common.Address, ownerID string) (string, error) {
+	var out []interface{}
+	err := _ICAModule.contract.Call(opts, &out, "queryAccountWithOwnerID", connectionID, addr, ownerID)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// QueryAccountWithOwnerID is a free data retrieval call binding the contract method 0x8f1b4695.
+//
+// Solidity: function queryAccountWithOwnerID(string connectionID, address addr, string ownerID) view returns(string)
+func (_ICAModule *ICAModuleSession) QueryAccountWithOwnerID(connectionID string, addr common.Address, ownerID string) (string, error) {
+	return _ICAModule.Contract.QueryAccountWithOwnerID(&_ICAModule.CallOpts, connectionID, addr, ownerID)
+}
+
+// QueryAccountWithOwnerID is a free data retrieval call binding the contract method 0x8f1b4695.
+//
+// Solidity: function queryAccountWithOwnerID(string connectionID, address addr, string ownerID) view returns(string)
+func (_ICAModule *ICAModuleCallerSession) QueryAccountWithOwnerID(connectionID string, addr common.Address, ownerID string) (string, error) {
+	return _ICAModule.Contract.QueryAccountWithOwnerID(&_ICAModule.CallOpts, connectionID, addr, ownerID)
+}
+
+// QueryActiveChannel is a free data retrieval call binding the contract method 0xdc241478.
+//
+// Solidity: function queryActiveChannel(string connectionID, address addr, string ownerID) view returns(string channelID, int32 state)
+func (_ICAModule *ICAModuleCaller) QueryActiveChannel(opts *bind.CallOpts, connectionID string, addr common.Address, ownerID string) (struct {
+	ChannelID string
+	State     int32
+}, error) {
+	var out []interface{}
+	err := _ICAModule.contract.Call(opts, &out, "queryActiveChannel", connectionID, addr, ownerID)
+
+	outstruct := new(struct {
+		ChannelID string
+		State     int32
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.ChannelID = *abi.ConvertType(out[0], new(string)).(*string)
+	outstruct.State = *abi.ConvertType(out[1], new(int32)).(*int32)
+
+	return *outstruct, err
+
+}
+
+// QueryActiveChannel is a free data retrieval call binding the contract method 0xdc241478.
+//
+// Solidity: function queryActiveChannel(string connectionID, address addr, string ownerID) view returns(string channelID, int32 state)
+func (_ICAModule *ICAModuleSession) QueryActiveChannel(connectionID string, addr common.Address, ownerID string) (struct {
+	ChannelID string
+	State     int32
+}, error) {
+	return _ICAModule.Contract.QueryActiveChannel(&_ICAModule.CallOpts, connectionID, addr, ownerID)
+}
+
+// QueryActiveChannel is a free data retrieval call binding the contract method 0xdc241478.
+//
+// Solidity: function queryActiveChannel(string connectionID, address addr, string ownerID) view returns(string channelID, int32 state)
+func (_ICAModule *ICAModuleCallerSession) QueryActiveChannel(connectionID string, addr common.Address, ownerID string) (struct {
+	ChannelID string
+	State     int32
+}, error) {
+	return _ICAModule.Contract.QueryActiveChannel(&_ICAModule.CallOpts, connectionID, addr, ownerID)
+}
+
 // RegisterAccount is a paid mutator transaction binding the contract method 0x9a20d545.
 //
 // Solidity: function registerAccount(string connectionID, string version, int32 ordering) payable returns(bool)
@@ -232,6 +353,48 @@ func (_ICAModule *ICAModuleTransactorSession) RegisterAccount(connectionID strin
 	return _ICAModule.Contract.RegisterAccount(&_ICAModule.TransactOpts, connectionID, version, ordering)
 }
 
+// RegisterAccountWithOwnerID is a paid mutator transaction binding the contract method 0x233b1240.
+//
+// Solidity: function registerAccountWithOwnerID(string connectionID, string ownerID, string version, int32 ordering) payable returns(bool)
+func (_ICAModule *ICAModuleTransactor) RegisterAccountWithOwnerID(opts *bind.TransactOpts, connectionID string, ownerID string, version string, ordering int32) (*types.Transaction, error) {
+	return _ICAModule.contract.Transact(opts, "registerAccountWithOwnerID", connectionID, ownerID, version, ordering)
+}
+
+// RegisterAccountWithOwnerID is a paid mutator transaction binding the contract method 0x233b1240.
+//
+// Solidity: function registerAccountWithOwnerID(string connectionID, string ownerID, string version, int32 ordering) payable returns(bool)
+func (_ICAModule *ICAModuleSession) RegisterAccountWithOwnerID(connectionID string, ownerID string, version string, ordering int32) (*types.Transaction, error) {
+	return _ICAModule.Contract.RegisterAccountWithOwnerID(&_ICAModule.TransactOpts, connectionID, ownerID, version, ordering)
+}
+
+// RegisterAccountWithOwnerID is a paid mutator transaction binding the contract method 0x233b1240.
+//
+// Solidity: function registerAccountWithOwnerID(string connectionID, string ownerID, string version, int32 ordering) payable returns(bool)
+func (_ICAModule *ICAModuleTransactorSession) RegisterAccountWithOwnerID(connectionID string, ownerID string, version string, ordering int32) (*types.Transaction, error) {
+	return _ICAModule.Contract.RegisterAccountWithOwnerID(&_ICAModule.TransactOpts, connectionID, ownerID, version, ordering)
+}
+
+// ReopenChannel is a paid mutator transaction binding the contract method 0x0463adec.
+//
+// Solidity: function reopenChannel(string connectionID, string ownerID, string version, int32 ordering) payable returns(bool)
+func (_ICAModule *ICAModuleTransactor) ReopenChannel(opts *bind.TransactOpts, connectionID string, ownerID string, version string, ordering int32) (*types.Transaction, error) {
+	return _ICAModule.contract.Transact(opts, "reopenChannel", connectionID, ownerID, version, ordering)
+}
+
+// ReopenChannel is a paid mutator transaction binding the contract method 0x0463adec.
+//
+// Solidity: function reopenChannel(string connectionID, string ownerID, string version, int32 ordering) payable returns(bool)
+func (_ICAModule *ICAModuleSession) ReopenChannel(connectionID string, ownerID string, version string, ordering int32) (*types.Transaction, error) {
+	return _ICAModule.Contract.ReopenChannel(&_ICAModule.TransactOpts, connectionID, ownerID, version, ordering)
+}
+
+// ReopenChannel is a paid mutator transaction binding the contract method 0x0463adec.
+//
+// Solidity: function reopenChannel(string connectionID, string ownerID, string version, int32 ordering) payable returns(bool)
+func (_ICAModule *ICAModuleTransactorSession) ReopenChannel(connectionID string, ownerID string, version string, ordering int32) (*types.Transaction, error) {
+	return _ICAModule.Contract.ReopenChannel(&_ICAModule.TransactOpts, connectionID, ownerID, version, ordering)
+}
+
 // SubmitMsgs is a paid mutator transaction binding the contract method 0x697bfa34.
 //
 // Solidity: function submitMsgs(string connectionID, bytes data, uint256 timeout) payable returns(uint64)
@@ -253,6 +416,48 @@ func (_ICAModule *ICAModuleTransactorSession) SubmitMsgs(connectionID string, da
 	return _ICAModule.Contract.SubmitMsgs(&_ICAModule.TransactOpts, connectionID, data, timeout)
 }
 
+// SubmitMsgsWithOwnerID is a paid mutator transaction binding the contract method 0x58ad5fae.
+//
+// Solidity: function submitMsgsWithOwnerID(string connectionID, string ownerID, bytes data, uint256 timeout) payable returns(uint64)
+func (_ICAModule *ICAModuleTransactor) SubmitMsgsWithOwnerID(opts *bind.TransactOpts, connectionID string, ownerID string, data []byte, timeout *big.Int) (*types.Transaction, error) {
+	return _ICAModule.contract.Transact(opts, "submitMsgsWithOwnerID", connectionID, ownerID, data, timeout)
+}
+
+// SubmitMsgsWithOwnerID is a paid mutator transaction binding the contract method 0x58ad5fae.
+//
+// Solidity: function submitMsgsWithOwnerID(string connectionID, string ownerID, bytes data, uint256 timeout) payable returns(uint64)
+func (_ICAModule *ICAModuleSession) SubmitMsgsWithOwnerID(connectionID string, ownerID string, data []byte, timeout *big.Int) (*types.Transaction, error) {
+	return _ICAModule.Contract.SubmitMsgsWithOwnerID(&_ICAModule.TransactOpts, connectionID, ownerID, data, timeout)
+}
+
+// SubmitMsgsWithOwnerID is a paid mutator transaction binding the contract method 0x58ad5fae.
+//
+// Solidity: function submitMsgsWithOwnerID(string connectionID, string ownerID, bytes data, uint256 timeout) payable returns(uint64)
+func (_ICAModule *ICAModuleTransactorSession) SubmitMsgsWithOwnerID(connectionID string, ownerID string, data []byte, timeout *big.Int) (*types.Transaction, error) {
+	return _ICAModule.Contract.SubmitMsgsWithOwnerID(&_ICAModule.TransactOpts, connectionID, ownerID, data, timeout)
+}
+
+// SubmitMsgsWithTimeoutTimestamp is a paid mutator transaction binding the contract method 0x861aff42.
+//
+// Solidity: function submitMsgsWithTimeoutTimestamp(string connectionID, string ownerID, bytes data, uint64 timeoutTimestamp) payable returns(uint64)
+func (_ICAModule *ICAModuleTransactor) SubmitMsgsWithTimeoutTimestamp(opts *bind.TransactOpts, connectionID string, ownerID string, data []byte, timeoutTimestamp uint64) (*types.Transaction, error) {
+	return _ICAModule.contract.Transact(opts, "submitMsgsWithTimeoutTimestamp", connectionID, ownerID, data, timeoutTimestamp)
+}
+
+// SubmitMsgsWithTimeoutTimestamp is a paid mutator transaction binding the contract method 0x861aff42.
+//
+// Solidity: function submitMsgsWithTimeoutTimestamp(string connectionID, string ownerID, bytes data, uint64 timeoutTimestamp) payable returns(uint64)
+func (_ICAModule *ICAModuleSession) SubmitMsgsWithTimeoutTimestamp(connectionID string, ownerID string, data []byte, timeoutTimestamp uint64) (*types.Transaction, error) {
+	return _ICAModule.Contract.SubmitMsgsWithTimeoutTimestamp(&_ICAModule.TransactOpts, connectionID, ownerID, data, timeoutTimestamp)
+}
+
+// SubmitMsgsWithTimeoutTimestamp is a paid mutator transaction binding the contract method 0x861aff42.
+//
+// Solidity: function submitMsgsWithTimeoutTimestamp(string connectionID, string ownerID, bytes data, uint64 timeoutTimestamp) payable returns(uint64)
+func (_ICAModule *ICAModuleTransactorSession) SubmitMsgsWithTimeoutTimestamp(connectionID string, ownerID string, data []byte, timeoutTimestamp uint64) (*types.Transaction, error) {
+	return _ICAModule.Contract.SubmitMsgsWithTimeoutTimestamp(&_ICAModule.TransactOpts, connectionID, ownerID, data, timeoutTimestamp)
+}
+
 // ICAModuleSubmitMsgsResultIterator is returned from FilterSubmitMsgsResult and is used to iterate over the raw logs and unpacked data for SubmitMsgsResult events raised by the ICAModule contract.
 type ICAModuleSubmitMsgsResultIterator struct {
 	Event *ICAModuleSubmitMsgsResult // Event containing the contract specifics and raw log
@@ -0,0 +1,233 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package icacallback
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ICACallbackV2MetaData contains all meta data concerning the ICACallbackV2 contract.
+var ICACallbackV2MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"packetSrcChannel\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"ack\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"result\",\"type\":\"bytes\"}],\"name\":\"onPacketResultCallbackV2\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// ICACallbackV2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use ICACallbackV2MetaData.ABI instead.
+var ICACallbackV2ABI = ICACallbackV2MetaData.ABI
+
+// ICACallbackV2 is an auto generated Go binding around an Ethereum contract.
+type ICACallbackV2 struct {
+	ICACallbackV2Caller     // Read-only binding to the contract
+	ICACallbackV2Transactor // Write-only binding to the contract
+	ICACallbackV2Filterer   // Log filterer for contract events
+}
+
+// ICACallbackV2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type ICACallbackV2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ICACallbackV2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type ICACallbackV2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ICACallbackV2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ICACallbackV2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ICACallbackV2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ICACallbackV2Session struct {
+	Contract     *ICACallbackV2    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ICACallbackV2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ICACallbackV2CallerSession struct {
+	Contract *ICACallbackV2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// ICACallbackV2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ICACallbackV2TransactorSession struct {
+	Contract     *ICACallbackV2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// ICACallbackV2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type ICACallbackV2Raw struct {
+	Contract *ICACallbackV2 // Generic contract binding to access the raw methods on
+}
+
+// ICACallbackV2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ICACallbackV2CallerRaw struct {
+	Contract *ICACallbackV2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// ICACallbackV2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ICACallbackV2TransactorRaw struct {
+	Contract *ICACallbackV2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewICACallbackV2 creates a new instance of ICACallbackV2, bound to a specific deployed contract.
+func NewICACallbackV2(address common.Address, backend bind.ContractBackend) (*ICACallbackV2, error) {
+	contract, err := bindICACallbackV2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ICACallbackV2{ICACallbackV2Caller: ICACallbackV2Caller{contract: contract}, ICACallbackV2Transactor: ICACallbackV2Transactor{contract: contract}, ICACallbackV2Filterer: ICACallbackV2Filterer{contract: contract}}, nil
+}
+
+// NewICACallbackV2Caller creates a new read-only instance of ICACallbackV2, bound to a specific deployed contract.
+func NewICACallbackV2Caller(address common.Address, caller bind.ContractCaller) (*ICACallbackV2Caller, error) {
+	contract, err := bindICACallbackV2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ICACallbackV2Caller{contract: contract}, nil
+}
+
+// NewICACallbackV2Transactor creates a new write-only instance of ICACallbackV2, bound to a specific deployed contract.
+func NewICACallbackV2Transactor(address common.Address, transactor bind.ContractTransactor) (*ICACallbackV2Transactor, error) {
+	contract, err := bindICACallbackV2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ICACallbackV2Transactor{contract: contract}, nil
+}
+
+// NewICACallbackV2Filterer creates a new log filterer instance of ICACallbackV2, bound to a specific deployed contract.
+func NewICACallbackV2Filterer(address common.Address, filterer bind.ContractFilterer) (*ICACallbackV2Filterer, error) {
+	contract, err := bindICACallbackV2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ICACallbackV2Filterer{contract: contract}, nil
+}
+
+// bindICACallbackV2 binds a generic wrapper to an already deployed contract.
+func bindICACallbackV2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ICACallbackV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ICACallbackV2 *ICACallbackV2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ICACallbackV2.Contract.ICACallbackV2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ICACallbackV2 *ICACallbackV2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ICACallbackV2.Contract.ICACallbackV2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ICACallbackV2 *ICACallbackV2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ICACallbackV2.Contract.ICACallbackV2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ICACallbackV2 *ICACallbackV2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ICACallbackV2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ICACallbackV2 *ICACallbackV2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ICACallbackV2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ICACallbackV2 *ICACallbackV2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ICACallbackV2.Contract.contract.Transact(opts, method, params...)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ICACallbackV2 *ICACallbackV2Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ICACallbackV2.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ICACallbackV2 *ICACallbackV2Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ICACallbackV2.Contract.SupportsInterface(&_ICACallbackV2.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ICACallbackV2 *ICACallbackV2CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ICACallbackV2.Contract.SupportsInterface(&_ICACallbackV2.CallOpts, interfaceId)
+}
+
+// OnPacketResultCallbackV2 is a paid mutator transaction binding the contract method 0x45b0a63e.
+//
+// Solidity: function onPacketResultCallbackV2(string packetSrcChannel, uint64 seq, bool ack, bytes result) payable returns(bool)
+func (_ICACallbackV2 *ICACallbackV2Transactor) OnPacketResultCallbackV2(opts *bind.TransactOpts, packetSrcChannel string, seq uint64, ack bool, result []byte) (*types.Transaction, error) {
+	return _ICACallbackV2.contract.Transact(opts, "onPacketResultCallbackV2", packetSrcChannel, seq, ack, result)
+}
+
+// OnPacketResultCallbackV2 is a paid mutator transaction binding the contract method 0x45b0a63e.
+//
+// Solidity: function onPacketResultCallbackV2(string packetSrcChannel, uint64 seq, bool ack, bytes result) payable returns(bool)
+func (_ICACallbackV2 *ICACallbackV2Session) OnPacketResultCallbackV2(packetSrcChannel string, seq uint64, ack bool, result []byte) (*types.Transaction, error) {
+	return _ICACallbackV2.Contract.OnPacketResultCallbackV2(&_ICACallbackV2.TransactOpts, packetSrcChannel, seq, ack, result)
+}
+
+// OnPacketResultCallbackV2 is a paid mutator transaction binding the contract method 0x45b0a63e.
+//
+// Solidity: function onPacketResultCallbackV2(string packetSrcChannel, uint64 seq, bool ack, bytes result) payable returns(bool)
+func (_ICACallbackV2 *ICACallbackV2TransactorSession) OnPacketResultCallbackV2(packetSrcChannel string, seq uint64, ack bool, result []byte) (*types.Transaction, error) {
+	return _ICACallbackV2.Contract.OnPacketResultCallbackV2(&_ICACallbackV2.TransactOpts, packetSrcChannel, seq, ack, result)
+}
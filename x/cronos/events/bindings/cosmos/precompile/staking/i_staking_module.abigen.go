@@ -0,0 +1,1231 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package staking
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CosmosCoin is an auto generated low-level Go binding around an user-defined struct.
+type CosmosCoin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+// StakingModuleMetaData contains all meta data concerning the StakingModule contract.
+var StakingModuleMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"creationHeight\",\"type\":\"uint64\"}],\"name\":\"CancelUnbondingDelegation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"newShares\",\"type\":\"string\"}],\"name\":\"Delegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sourceValidator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationValidator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"}],\"name\":\"Redelegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"SetWithdrawAddress\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"completionTime\",\"type\":\"string\"}],\"name\":\"Unbond\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"indexed\":false,\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawRewards\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"cancelUnbondingDelegation\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"delegate\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"redelegate\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"undelegate\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"withdrawDelegatorReward\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// StakingModuleABI is the input ABI used to generate the binding from.
+// Deprecated: Use StakingModuleMetaData.ABI instead.
+var StakingModuleABI = StakingModuleMetaData.ABI
+
+// StakingModule is an auto generated Go binding around an Ethereum contract.
+type StakingModule struct {
+	StakingModuleCaller     // Read-only binding to the contract
+	StakingModuleTransactor // Write-only binding to the contract
+	StakingModuleFilterer   // Log filterer for contract events
+}
+
+// StakingModuleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StakingModuleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingModuleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StakingModuleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingModuleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StakingModuleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingModuleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StakingModuleSession struct {
+	Contract     *StakingModule    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StakingModuleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StakingModuleCallerSession struct {
+	Contract *StakingModuleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// StakingModuleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StakingModuleTransactorSession struct {
+	Contract     *StakingModuleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// StakingModuleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StakingModuleRaw struct {
+	Contract *StakingModule // Generic contract binding to access the raw methods on
+}
+
+// StakingModuleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StakingModuleCallerRaw struct {
+	Contract *StakingModuleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StakingModuleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StakingModuleTransactorRaw struct {
+	Contract *StakingModuleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStakingModule creates a new instance of StakingModule, bound to a specific deployed contract.
+func NewStakingModule(address common.Address, backend bind.ContractBackend) (*StakingModule, error) {
+	contract, err := bindStakingModule(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModule{StakingModuleCaller: StakingModuleCaller{contract: contract}, StakingModuleTransactor: StakingModuleTransactor{contract: contract}, StakingModuleFilterer: StakingModuleFilterer{contract: contract}}, nil
+}
+
+// NewStakingModuleCaller creates a new read-only instance of StakingModule, bound to a specific deployed contract.
+func NewStakingModuleCaller(address common.Address, caller bind.ContractCaller) (*StakingModuleCaller, error) {
+	contract, err := bindStakingModule(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleCaller{contract: contract}, nil
+}
+
+// NewStakingModuleTransactor creates a new write-only instance of StakingModule, bound to a specific deployed contract.
+func NewStakingModuleTransactor(address common.Address, transactor bind.ContractTransactor) (*StakingModuleTransactor, error) {
+	contract, err := bindStakingModule(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleTransactor{contract: contract}, nil
+}
+
+// NewStakingModuleFilterer creates a new log filterer instance of StakingModule, bound to a specific deployed contract.
+func NewStakingModuleFilterer(address common.Address, filterer bind.ContractFilterer) (*StakingModuleFilterer, error) {
+	contract, err := bindStakingModule(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleFilterer{contract: contract}, nil
+}
+
+// bindStakingModule binds a generic wrapper to an already deployed contract.
+func bindStakingModule(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StakingModuleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StakingModule *StakingModuleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StakingModule.Contract.StakingModuleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StakingModule *StakingModuleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakingModule.Contract.StakingModuleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StakingModule *StakingModuleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StakingModule.Contract.StakingModuleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StakingModule *StakingModuleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StakingModule.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StakingModule *StakingModuleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StakingModule.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StakingModule *StakingModuleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StakingModule.Contract.contract.Transact(opts, method, params...)
+}
+
+// CancelUnbondingDelegation is a paid mutator transaction binding the contract method 0xd0521ef6.
+//
+// Solidity: function cancelUnbondingDelegation(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactor) CancelUnbondingDelegation(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "cancelUnbondingDelegation", data)
+}
+
+// CancelUnbondingDelegation is a paid mutator transaction binding the contract method 0xd0521ef6.
+//
+// Solidity: function cancelUnbondingDelegation(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleSession) CancelUnbondingDelegation(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.CancelUnbondingDelegation(&_StakingModule.TransactOpts, data)
+}
+
+// CancelUnbondingDelegation is a paid mutator transaction binding the contract method 0xd0521ef6.
+//
+// Solidity: function cancelUnbondingDelegation(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactorSession) CancelUnbondingDelegation(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.CancelUnbondingDelegation(&_StakingModule.TransactOpts, data)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x0ccfac9e.
+//
+// Solidity: function delegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactor) Delegate(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "delegate", data)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x0ccfac9e.
+//
+// Solidity: function delegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleSession) Delegate(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.Delegate(&_StakingModule.TransactOpts, data)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x0ccfac9e.
+//
+// Solidity: function delegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactorSession) Delegate(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.Delegate(&_StakingModule.TransactOpts, data)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x170f22eb.
+//
+// Solidity: function redelegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactor) Redelegate(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "redelegate", data)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x170f22eb.
+//
+// Solidity: function redelegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleSession) Redelegate(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.Redelegate(&_StakingModule.TransactOpts, data)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x170f22eb.
+//
+// Solidity: function redelegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactorSession) Redelegate(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.Redelegate(&_StakingModule.TransactOpts, data)
+}
+
+// SetWithdrawAddress is a paid mutator transaction binding the contract method 0xabd20951.
+//
+// Solidity: function setWithdrawAddress(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactor) SetWithdrawAddress(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "setWithdrawAddress", data)
+}
+
+// SetWithdrawAddress is a paid mutator transaction binding the contract method 0xabd20951.
+//
+// Solidity: function setWithdrawAddress(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleSession) SetWithdrawAddress(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.SetWithdrawAddress(&_StakingModule.TransactOpts, data)
+}
+
+// SetWithdrawAddress is a paid mutator transaction binding the contract method 0xabd20951.
+//
+// Solidity: function setWithdrawAddress(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactorSession) SetWithdrawAddress(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.SetWithdrawAddress(&_StakingModule.TransactOpts, data)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0xc708ee00.
+//
+// Solidity: function undelegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactor) Undelegate(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "undelegate", data)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0xc708ee00.
+//
+// Solidity: function undelegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleSession) Undelegate(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.Undelegate(&_StakingModule.TransactOpts, data)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0xc708ee00.
+//
+// Solidity: function undelegate(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactorSession) Undelegate(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.Undelegate(&_StakingModule.TransactOpts, data)
+}
+
+// WithdrawDelegatorReward is a paid mutator transaction binding the contract method 0x3dd0c44f.
+//
+// Solidity: function withdrawDelegatorReward(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactor) WithdrawDelegatorReward(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _StakingModule.contract.Transact(opts, "withdrawDelegatorReward", data)
+}
+
+// WithdrawDelegatorReward is a paid mutator transaction binding the contract method 0x3dd0c44f.
+//
+// Solidity: function withdrawDelegatorReward(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleSession) WithdrawDelegatorReward(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.WithdrawDelegatorReward(&_StakingModule.TransactOpts, data)
+}
+
+// WithdrawDelegatorReward is a paid mutator transaction binding the contract method 0x3dd0c44f.
+//
+// Solidity: function withdrawDelegatorReward(bytes data) payable returns(bytes)
+func (_StakingModule *StakingModuleTransactorSession) WithdrawDelegatorReward(data []byte) (*types.Transaction, error) {
+	return _StakingModule.Contract.WithdrawDelegatorReward(&_StakingModule.TransactOpts, data)
+}
+
+// StakingModuleCancelUnbondingDelegationIterator is returned from FilterCancelUnbondingDelegation and is used to iterate over the raw logs and unpacked data for CancelUnbondingDelegation events raised by the StakingModule contract.
+type StakingModuleCancelUnbondingDelegationIterator struct {
+	Event *StakingModuleCancelUnbondingDelegation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingModuleCancelUnbondingDelegationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingModuleCancelUnbondingDelegation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingModuleCancelUnbondingDelegation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingModuleCancelUnbondingDelegationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingModuleCancelUnbondingDelegationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingModuleCancelUnbondingDelegation represents a CancelUnbondingDelegation event raised by the StakingModule contract.
+type StakingModuleCancelUnbondingDelegation struct {
+	Validator      common.Address
+	Delegator      common.Address
+	Amount         []CosmosCoin
+	CreationHeight uint64
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterCancelUnbondingDelegation is a free log retrieval operation binding the contract event 0xd565f1cf5d541b72df8c1ac2f05918c549ae03816a75a42424748475e9ad923c.
+//
+// Solidity: event CancelUnbondingDelegation(address indexed validator, address indexed delegator, (uint256,string)[] amount, uint64 creationHeight)
+func (_StakingModule *StakingModuleFilterer) FilterCancelUnbondingDelegation(opts *bind.FilterOpts, validator []common.Address, delegator []common.Address) (*StakingModuleCancelUnbondingDelegationIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.FilterLogs(opts, "CancelUnbondingDelegation", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleCancelUnbondingDelegationIterator{contract: _StakingModule.contract, event: "CancelUnbondingDelegation", logs: logs, sub: sub}, nil
+}
+
+// WatchCancelUnbondingDelegation is a free log subscription operation binding the contract event 0xd565f1cf5d541b72df8c1ac2f05918c549ae03816a75a42424748475e9ad923c.
+//
+// Solidity: event CancelUnbondingDelegation(address indexed validator, address indexed delegator, (uint256,string)[] amount, uint64 creationHeight)
+func (_StakingModule *StakingModuleFilterer) WatchCancelUnbondingDelegation(opts *bind.WatchOpts, sink chan<- *StakingModuleCancelUnbondingDelegation, validator []common.Address, delegator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.WatchLogs(opts, "CancelUnbondingDelegation", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingModuleCancelUnbondingDelegation)
+				if err := _StakingModule.contract.UnpackLog(event, "CancelUnbondingDelegation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCancelUnbondingDelegation is a log parse operation binding the contract event 0xd565f1cf5d541b72df8c1ac2f05918c549ae03816a75a42424748475e9ad923c.
+//
+// Solidity: event CancelUnbondingDelegation(address indexed validator, address indexed delegator, (uint256,string)[] amount, uint64 creationHeight)
+func (_StakingModule *StakingModuleFilterer) ParseCancelUnbondingDelegation(log types.Log) (*StakingModuleCancelUnbondingDelegation, error) {
+	event := new(StakingModuleCancelUnbondingDelegation)
+	if err := _StakingModule.contract.UnpackLog(event, "CancelUnbondingDelegation", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingModuleDelegateIterator is returned from FilterDelegate and is used to iterate over the raw logs and unpacked data for Delegate events raised by the StakingModule contract.
+type StakingModuleDelegateIterator struct {
+	Event *StakingModuleDelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingModuleDelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingModuleDelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingModuleDelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingModuleDelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingModuleDelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingModuleDelegate represents a Delegate event raised by the StakingModule contract.
+type StakingModuleDelegate struct {
+	Validator common.Address
+	Delegator common.Address
+	Amount    []CosmosCoin
+	NewShares string
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDelegate is a free log retrieval operation binding the contract event 0xa7b7ffac78ff92c91eebdb5f081a1e21f03222a72a58d6310595ddb4e0c22068.
+//
+// Solidity: event Delegate(address indexed validator, address indexed delegator, (uint256,string)[] amount, string newShares)
+func (_StakingModule *StakingModuleFilterer) FilterDelegate(opts *bind.FilterOpts, validator []common.Address, delegator []common.Address) (*StakingModuleDelegateIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.FilterLogs(opts, "Delegate", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleDelegateIterator{contract: _StakingModule.contract, event: "Delegate", logs: logs, sub: sub}, nil
+}
+
+// WatchDelegate is a free log subscription operation binding the contract event 0xa7b7ffac78ff92c91eebdb5f081a1e21f03222a72a58d6310595ddb4e0c22068.
+//
+// Solidity: event Delegate(address indexed validator, address indexed delegator, (uint256,string)[] amount, string newShares)
+func (_StakingModule *StakingModuleFilterer) WatchDelegate(opts *bind.WatchOpts, sink chan<- *StakingModuleDelegate, validator []common.Address, delegator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.WatchLogs(opts, "Delegate", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingModuleDelegate)
+				if err := _StakingModule.contract.UnpackLog(event, "Delegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDelegate is a log parse operation binding the contract event 0xa7b7ffac78ff92c91eebdb5f081a1e21f03222a72a58d6310595ddb4e0c22068.
+//
+// Solidity: event Delegate(address indexed validator, address indexed delegator, (uint256,string)[] amount, string newShares)
+func (_StakingModule *StakingModuleFilterer) ParseDelegate(log types.Log) (*StakingModuleDelegate, error) {
+	event := new(StakingModuleDelegate)
+	if err := _StakingModule.contract.UnpackLog(event, "Delegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingModuleRedelegateIterator is returned from FilterRedelegate and is used to iterate over the raw logs and unpacked data for Redelegate events raised by the StakingModule contract.
+type StakingModuleRedelegateIterator struct {
+	Event *StakingModuleRedelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingModuleRedelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingModuleRedelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingModuleRedelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingModuleRedelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingModuleRedelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingModuleRedelegate represents a Redelegate event raised by the StakingModule contract.
+type StakingModuleRedelegate struct {
+	SourceValidator      common.Address
+	DestinationValidator common.Address
+	Amount               []CosmosCoin
+	CompletionTime       string
+	Raw                  types.Log // Blockchain specific contextual infos
+}
+
+// FilterRedelegate is a free log retrieval operation binding the contract event 0xf359c3962b16c73dea541dc5e00aa53358499963590f8b93a85fe7b70b2f4d43.
+//
+// Solidity: event Redelegate(address indexed sourceValidator, address indexed destinationValidator, (uint256,string)[] amount, string completionTime)
+func (_StakingModule *StakingModuleFilterer) FilterRedelegate(opts *bind.FilterOpts, sourceValidator []common.Address, destinationValidator []common.Address) (*StakingModuleRedelegateIterator, error) {
+
+	var sourceValidatorRule []interface{}
+	for _, sourceValidatorItem := range sourceValidator {
+		sourceValidatorRule = append(sourceValidatorRule, sourceValidatorItem)
+	}
+	var destinationValidatorRule []interface{}
+	for _, destinationValidatorItem := range destinationValidator {
+		destinationValidatorRule = append(destinationValidatorRule, destinationValidatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.FilterLogs(opts, "Redelegate", sourceValidatorRule, destinationValidatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleRedelegateIterator{contract: _StakingModule.contract, event: "Redelegate", logs: logs, sub: sub}, nil
+}
+
+// WatchRedelegate is a free log subscription operation binding the contract event 0xf359c3962b16c73dea541dc5e00aa53358499963590f8b93a85fe7b70b2f4d43.
+//
+// Solidity: event Redelegate(address indexed sourceValidator, address indexed destinationValidator, (uint256,string)[] amount, string completionTime)
+func (_StakingModule *StakingModuleFilterer) WatchRedelegate(opts *bind.WatchOpts, sink chan<- *StakingModuleRedelegate, sourceValidator []common.Address, destinationValidator []common.Address) (event.Subscription, error) {
+
+	var sourceValidatorRule []interface{}
+	for _, sourceValidatorItem := range sourceValidator {
+		sourceValidatorRule = append(sourceValidatorRule, sourceValidatorItem)
+	}
+	var destinationValidatorRule []interface{}
+	for _, destinationValidatorItem := range destinationValidator {
+		destinationValidatorRule = append(destinationValidatorRule, destinationValidatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.WatchLogs(opts, "Redelegate", sourceValidatorRule, destinationValidatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingModuleRedelegate)
+				if err := _StakingModule.contract.UnpackLog(event, "Redelegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRedelegate is a log parse operation binding the contract event 0xf359c3962b16c73dea541dc5e00aa53358499963590f8b93a85fe7b70b2f4d43.
+//
+// Solidity: event Redelegate(address indexed sourceValidator, address indexed destinationValidator, (uint256,string)[] amount, string completionTime)
+func (_StakingModule *StakingModuleFilterer) ParseRedelegate(log types.Log) (*StakingModuleRedelegate, error) {
+	event := new(StakingModuleRedelegate)
+	if err := _StakingModule.contract.UnpackLog(event, "Redelegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingModuleSetWithdrawAddressIterator is returned from FilterSetWithdrawAddress and is used to iterate over the raw logs and unpacked data for SetWithdrawAddress events raised by the StakingModule contract.
+type StakingModuleSetWithdrawAddressIterator struct {
+	Event *StakingModuleSetWithdrawAddress // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingModuleSetWithdrawAddressIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingModuleSetWithdrawAddress)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingModuleSetWithdrawAddress)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingModuleSetWithdrawAddressIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingModuleSetWithdrawAddressIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingModuleSetWithdrawAddress represents a SetWithdrawAddress event raised by the StakingModule contract.
+type StakingModuleSetWithdrawAddress struct {
+	WithdrawAddress common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetWithdrawAddress is a free log retrieval operation binding the contract event 0xb13cf87e0a7b64f90565a1b68b63ae634d746fa785450bbdef7cbd281997cfb0.
+//
+// Solidity: event SetWithdrawAddress(address indexed withdrawAddress)
+func (_StakingModule *StakingModuleFilterer) FilterSetWithdrawAddress(opts *bind.FilterOpts, withdrawAddress []common.Address) (*StakingModuleSetWithdrawAddressIterator, error) {
+
+	var withdrawAddressRule []interface{}
+	for _, withdrawAddressItem := range withdrawAddress {
+		withdrawAddressRule = append(withdrawAddressRule, withdrawAddressItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.FilterLogs(opts, "SetWithdrawAddress", withdrawAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleSetWithdrawAddressIterator{contract: _StakingModule.contract, event: "SetWithdrawAddress", logs: logs, sub: sub}, nil
+}
+
+// WatchSetWithdrawAddress is a free log subscription operation binding the contract event 0xb13cf87e0a7b64f90565a1b68b63ae634d746fa785450bbdef7cbd281997cfb0.
+//
+// Solidity: event SetWithdrawAddress(address indexed withdrawAddress)
+func (_StakingModule *StakingModuleFilterer) WatchSetWithdrawAddress(opts *bind.WatchOpts, sink chan<- *StakingModuleSetWithdrawAddress, withdrawAddress []common.Address) (event.Subscription, error) {
+
+	var withdrawAddressRule []interface{}
+	for _, withdrawAddressItem := range withdrawAddress {
+		withdrawAddressRule = append(withdrawAddressRule, withdrawAddressItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.WatchLogs(opts, "SetWithdrawAddress", withdrawAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingModuleSetWithdrawAddress)
+				if err := _StakingModule.contract.UnpackLog(event, "SetWithdrawAddress", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetWithdrawAddress is a log parse operation binding the contract event 0xb13cf87e0a7b64f90565a1b68b63ae634d746fa785450bbdef7cbd281997cfb0.
+//
+// Solidity: event SetWithdrawAddress(address indexed withdrawAddress)
+func (_StakingModule *StakingModuleFilterer) ParseSetWithdrawAddress(log types.Log) (*StakingModuleSetWithdrawAddress, error) {
+	event := new(StakingModuleSetWithdrawAddress)
+	if err := _StakingModule.contract.UnpackLog(event, "SetWithdrawAddress", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingModuleUnbondIterator is returned from FilterUnbond and is used to iterate over the raw logs and unpacked data for Unbond events raised by the StakingModule contract.
+type StakingModuleUnbondIterator struct {
+	Event *StakingModuleUnbond // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingModuleUnbondIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingModuleUnbond)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingModuleUnbond)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingModuleUnbondIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingModuleUnbondIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingModuleUnbond represents a Unbond event raised by the StakingModule contract.
+type StakingModuleUnbond struct {
+	Validator      common.Address
+	Delegator      common.Address
+	Amount         []CosmosCoin
+	CompletionTime string
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnbond is a free log retrieval operation binding the contract event 0x36b02e0fa448e954961ed2d2b1d0e5e40c195f6208ed9095e5cded0f279d0587.
+//
+// Solidity: event Unbond(address indexed validator, address indexed delegator, (uint256,string)[] amount, string completionTime)
+func (_StakingModule *StakingModuleFilterer) FilterUnbond(opts *bind.FilterOpts, validator []common.Address, delegator []common.Address) (*StakingModuleUnbondIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.FilterLogs(opts, "Unbond", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleUnbondIterator{contract: _StakingModule.contract, event: "Unbond", logs: logs, sub: sub}, nil
+}
+
+// WatchUnbond is a free log subscription operation binding the contract event 0x36b02e0fa448e954961ed2d2b1d0e5e40c195f6208ed9095e5cded0f279d0587.
+//
+// Solidity: event Unbond(address indexed validator, address indexed delegator, (uint256,string)[] amount, string completionTime)
+func (_StakingModule *StakingModuleFilterer) WatchUnbond(opts *bind.WatchOpts, sink chan<- *StakingModuleUnbond, validator []common.Address, delegator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.WatchLogs(opts, "Unbond", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingModuleUnbond)
+				if err := _StakingModule.contract.UnpackLog(event, "Unbond", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnbond is a log parse operation binding the contract event 0x36b02e0fa448e954961ed2d2b1d0e5e40c195f6208ed9095e5cded0f279d0587.
+//
+// Solidity: event Unbond(address indexed validator, address indexed delegator, (uint256,string)[] amount, string completionTime)
+func (_StakingModule *StakingModuleFilterer) ParseUnbond(log types.Log) (*StakingModuleUnbond, error) {
+	event := new(StakingModuleUnbond)
+	if err := _StakingModule.contract.UnpackLog(event, "Unbond", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingModuleWithdrawRewardsIterator is returned from FilterWithdrawRewards and is used to iterate over the raw logs and unpacked data for WithdrawRewards events raised by the StakingModule contract.
+type StakingModuleWithdrawRewardsIterator struct {
+	Event *StakingModuleWithdrawRewards // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingModuleWithdrawRewardsIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingModuleWithdrawRewards)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingModuleWithdrawRewards)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingModuleWithdrawRewardsIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingModuleWithdrawRewardsIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingModuleWithdrawRewards represents a WithdrawRewards event raised by the StakingModule contract.
+type StakingModuleWithdrawRewards struct {
+	Validator common.Address
+	Delegator common.Address
+	Amount    []CosmosCoin
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawRewards is a free log retrieval operation binding the contract event 0xea90ee99f77ad2787b179ea11e9c6255ed1a6bce678afb452a0e51d046592f67.
+//
+// Solidity: event WithdrawRewards(address indexed validator, address indexed delegator, (uint256,string)[] amount)
+func (_StakingModule *StakingModuleFilterer) FilterWithdrawRewards(opts *bind.FilterOpts, validator []common.Address, delegator []common.Address) (*StakingModuleWithdrawRewardsIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.FilterLogs(opts, "WithdrawRewards", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingModuleWithdrawRewardsIterator{contract: _StakingModule.contract, event: "WithdrawRewards", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawRewards is a free log subscription operation binding the contract event 0xea90ee99f77ad2787b179ea11e9c6255ed1a6bce678afb452a0e51d046592f67.
+//
+// Solidity: event WithdrawRewards(address indexed validator, address indexed delegator, (uint256,string)[] amount)
+func (_StakingModule *StakingModuleFilterer) WatchWithdrawRewards(opts *bind.WatchOpts, sink chan<- *StakingModuleWithdrawRewards, validator []common.Address, delegator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _StakingModule.contract.WatchLogs(opts, "WithdrawRewards", validatorRule, delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingModuleWithdrawRewards)
+				if err := _StakingModule.contract.UnpackLog(event, "WithdrawRewards", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawRewards is a log parse operation binding the contract event 0xea90ee99f77ad2787b179ea11e9c6255ed1a6bce678afb452a0e51d046592f67.
+//
+// Solidity: event WithdrawRewards(address indexed validator, address indexed delegator, (uint256,string)[] amount)
+func (_StakingModule *StakingModuleFilterer) ParseWithdrawRewards(log types.Log) (*StakingModuleWithdrawRewards, error) {
+	event := new(StakingModuleWithdrawRewards)
+	if err := _StakingModule.contract.UnpackLog(event, "WithdrawRewards", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,347 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package transfer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// TransferModuleMetaData contains all meta data concerning the TransferModule contract.
+var TransferModuleMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"packetSrcChannel\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"TransferResult\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelID\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timeout\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// TransferModuleABI is the input ABI used to generate the binding from.
+// Deprecated: Use TransferModuleMetaData.ABI instead.
+var TransferModuleABI = TransferModuleMetaData.ABI
+
+// TransferModule is an auto generated Go binding around an Ethereum contract.
+type TransferModule struct {
+	TransferModuleCaller     // Read-only binding to the contract
+	TransferModuleTransactor // Write-only binding to the contract
+	TransferModuleFilterer   // Log filterer for contract events
+}
+
+// TransferModuleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TransferModuleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransferModuleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TransferModuleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransferModuleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TransferModuleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransferModuleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TransferModuleSession struct {
+	Contract     *TransferModule   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TransferModuleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TransferModuleCallerSession struct {
+	Contract *TransferModuleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// TransferModuleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TransferModuleTransactorSession struct {
+	Contract     *TransferModuleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// TransferModuleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TransferModuleRaw struct {
+	Contract *TransferModule // Generic contract binding to access the raw methods on
+}
+
+// TransferModuleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TransferModuleCallerRaw struct {
+	Contract *TransferModuleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TransferModuleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TransferModuleTransactorRaw struct {
+	Contract *TransferModuleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTransferModule creates a new instance of TransferModule, bound to a specific deployed contract.
+func NewTransferModule(address common.Address, backend bind.ContractBackend) (*TransferModule, error) {
+	contract, err := bindTransferModule(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferModule{TransferModuleCaller: TransferModuleCaller{contract: contract}, TransferModuleTransactor: TransferModuleTransactor{contract: contract}, TransferModuleFilterer: TransferModuleFilterer{contract: contract}}, nil
+}
+
+// NewTransferModuleCaller creates a new read-only instance of TransferModule, bound to a specific deployed contract.
+func NewTransferModuleCaller(address common.Address, caller bind.ContractCaller) (*TransferModuleCaller, error) {
+	contract, err := bindTransferModule(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferModuleCaller{contract: contract}, nil
+}
+
+// NewTransferModuleTransactor creates a new write-only instance of TransferModule, bound to a specific deployed contract.
+func NewTransferModuleTransactor(address common.Address, transactor bind.ContractTransactor) (*TransferModuleTransactor, error) {
+	contract, err := bindTransferModule(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferModuleTransactor{contract: contract}, nil
+}
+
+// NewTransferModuleFilterer creates a new log filterer instance of TransferModule, bound to a specific deployed contract.
+func NewTransferModuleFilterer(address common.Address, filterer bind.ContractFilterer) (*TransferModuleFilterer, error) {
+	contract, err := bindTransferModule(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferModuleFilterer{contract: contract}, nil
+}
+
+// bindTransferModule binds a generic wrapper to an already deployed contract.
+func bindTransferModule(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := TransferModuleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TransferModule *TransferModuleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TransferModule.Contract.TransferModuleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TransferModule *TransferModuleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransferModule.Contract.TransferModuleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TransferModule *TransferModuleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TransferModule.Contract.TransferModuleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TransferModule *TransferModuleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TransferModule.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TransferModule *TransferModuleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransferModule.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TransferModule *TransferModuleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TransferModule.Contract.contract.Transact(opts, method, params...)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0x31fd752e.
+//
+// Solidity: function transfer(string channelID, string receiver, string denom, uint256 amount, uint256 timeout) payable returns(uint64)
+func (_TransferModule *TransferModuleTransactor) Transfer(opts *bind.TransactOpts, channelID string, receiver string, denom string, amount *big.Int, timeout *big.Int) (*types.Transaction, error) {
+	return _TransferModule.contract.Transact(opts, "transfer", channelID, receiver, denom, amount, timeout)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0x31fd752e.
+//
+// Solidity: function transfer(string channelID, string receiver, string denom, uint256 amount, uint256 timeout) payable returns(uint64)
+func (_TransferModule *TransferModuleSession) Transfer(channelID string, receiver string, denom string, amount *big.Int, timeout *big.Int) (*types.Transaction, error) {
+	return _TransferModule.Contract.Transfer(&_TransferModule.TransactOpts, channelID, receiver, denom, amount, timeout)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0x31fd752e.
+//
+// Solidity: function transfer(string channelID, string receiver, string denom, uint256 amount, uint256 timeout) payable returns(uint64)
+func (_TransferModule *TransferModuleTransactorSession) Transfer(channelID string, receiver string, denom string, amount *big.Int, timeout *big.Int) (*types.Transaction, error) {
+	return _TransferModule.Contract.Transfer(&_TransferModule.TransactOpts, channelID, receiver, denom, amount, timeout)
+}
+
+// TransferModuleTransferResultIterator is returned from FilterTransferResult and is used to iterate over the raw logs and unpacked data for TransferResult events raised by the TransferModule contract.
+type TransferModuleTransferResultIterator struct {
+	Event *TransferModuleTransferResult // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransferModuleTransferResultIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TransferModuleTransferResult)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TransferModuleTransferResult)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransferModuleTransferResultIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransferModuleTransferResultIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TransferModuleTransferResult represents a TransferResult event raised by the TransferModule contract.
+type TransferModuleTransferResult struct {
+	PacketSrcChannel common.Hash
+	Seq              uint64
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferResult is a free log retrieval operation binding the contract event 0x069430895732633a20f6096b92e37d428c39f194df7916ea5fd323b178daee80.
+//
+// Solidity: event TransferResult(string indexed packetSrcChannel, uint64 seq)
+func (_TransferModule *TransferModuleFilterer) FilterTransferResult(opts *bind.FilterOpts, packetSrcChannel []string) (*TransferModuleTransferResultIterator, error) {
+
+	var packetSrcChannelRule []interface{}
+	for _, packetSrcChannelItem := range packetSrcChannel {
+		packetSrcChannelRule = append(packetSrcChannelRule, packetSrcChannelItem)
+	}
+
+	logs, sub, err := _TransferModule.contract.FilterLogs(opts, "TransferResult", packetSrcChannelRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferModuleTransferResultIterator{contract: _TransferModule.contract, event: "TransferResult", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferResult is a free log subscription operation binding the contract event 0x069430895732633a20f6096b92e37d428c39f194df7916ea5fd323b178daee80.
+//
+// Solidity: event TransferResult(string indexed packetSrcChannel, uint64 seq)
+func (_TransferModule *TransferModuleFilterer) WatchTransferResult(opts *bind.WatchOpts, sink chan<- *TransferModuleTransferResult, packetSrcChannel []string) (event.Subscription, error) {
+
+	var packetSrcChannelRule []interface{}
+	for _, packetSrcChannelItem := range packetSrcChannel {
+		packetSrcChannelRule = append(packetSrcChannelRule, packetSrcChannelItem)
+	}
+
+	logs, sub, err := _TransferModule.contract.WatchLogs(opts, "TransferResult", packetSrcChannelRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TransferModuleTransferResult)
+				if err := _TransferModule.contract.UnpackLog(event, "TransferResult", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferResult is a log parse operation binding the contract event 0x069430895732633a20f6096b92e37d428c39f194df7916ea5fd323b178daee80.
+//
+// Solidity: event TransferResult(string indexed packetSrcChannel, uint64 seq)
+func (_TransferModule *TransferModuleFilterer) ParseTransferResult(log types.Log) (*TransferModuleTransferResult, error) {
+	event := new(TransferModuleTransferResult)
+	if err := _TransferModule.contract.UnpackLog(event, "TransferResult", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -6,4 +6,7 @@ interface IBankModule {
     function balanceOf(address,address) external view returns (uint256);
     function burn(address,uint256) external payable returns (bool);
     function transfer(address,address,uint256) external payable returns (bool);
+    function approve(address token, address spender, uint256 amount) external payable returns (bool);
+    function allowance(address token, address owner, address spender) external view returns (uint256);
+    function transferFrom(address token, address from, address to, uint256 amount) external payable returns (bool);
 }
@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+interface IE2EEModule {
+    function registerEncryptionKey(string calldata key) external payable returns (bool);
+    function key(address addr) external view returns (string memory);
+    function keys(address[] calldata addrs) external view returns (string[] memory);
+}
@@ -0,0 +1,7 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+interface IExecModule {
+    event CosmosEvent(string indexed eventType, string[] keys, string[] values);
+    function exec(bytes[] calldata msgs) external payable returns (bytes[] memory);
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+import {Cosmos} from "./CosmosTypes.sol";
+
+interface IGovModule {
+    event SubmitProposal(uint64 indexed proposalId, address indexed proposalProposer, string proposalMessages);
+    event ProposalDeposit(uint64 indexed proposalId, address indexed depositor, Cosmos.Coin[] amount);
+    event ProposalVote(uint64 indexed proposalId, address indexed voter, string option);
+    function submitProposal(bytes calldata data) external payable returns (bytes calldata);
+    function deposit(bytes calldata data) external payable returns (bytes calldata);
+    function vote(bytes calldata data) external payable returns (bytes calldata);
+    function voteWeighted(bytes calldata data) external payable returns (bytes calldata);
+    function getProposal(uint64 proposalId) external view returns (bytes memory);
+    function getTallyResult(uint64 proposalId) external view returns (bytes memory);
+}
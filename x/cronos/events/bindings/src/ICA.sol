@@ -4,6 +4,13 @@ pragma solidity ^0.8.4;
 interface IICAModule {
     event SubmitMsgsResult(string indexed packetSrcChannel, uint64 seq);
     function registerAccount(string calldata connectionID, string calldata version, int32 ordering) external payable returns (bool);
+    function registerAccountWithOwnerID(string calldata connectionID, string calldata ownerID, string calldata version, int32 ordering) external payable returns (bool);
+    function reopenChannel(string calldata connectionID, string calldata ownerID, string calldata version, int32 ordering) external payable returns (bool);
     function queryAccount(string calldata connectionID, address addr) external view returns (string memory);
+    function queryAccountWithOwnerID(string calldata connectionID, address addr, string calldata ownerID) external view returns (string memory);
+    function queryActiveChannel(string calldata connectionID, address addr, string calldata ownerID) external view returns (string memory channelID, int32 state);
     function submitMsgs(string calldata connectionID, bytes calldata data, uint256 timeout) external payable returns (uint64);
+    function submitMsgsWithOwnerID(string calldata connectionID, string calldata ownerID, bytes calldata data, uint256 timeout) external payable returns (uint64);
+    function submitMsgsWithTimeoutTimestamp(string calldata connectionID, string calldata ownerID, bytes calldata data, uint64 timeoutTimestamp) external payable returns (uint64);
+    function decodeTxMsgResults(bytes calldata result) external view returns (string[] memory typeUrls, bytes[] memory responses);
 }
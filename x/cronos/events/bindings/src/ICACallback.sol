@@ -4,3 +4,13 @@ pragma solidity ^0.8.4;
 interface IICACallback {
     function onPacketResultCallback(string calldata packetSrcChannel, uint64 seq, bool ack) external payable returns (bool);
 }
+
+interface IERC165 {
+    function supportsInterface(bytes4 interfaceId) external view returns (bool);
+}
+
+// IICACallbackV2 delivers the result bytes of the acknowledgement as well, the contract opts in by reporting
+// `type(IICACallbackV2).interfaceId` through `supportsInterface`, otherwise `onPacketResultCallback` is called.
+interface IICACallbackV2 is IERC165 {
+    function onPacketResultCallbackV2(string calldata packetSrcChannel, uint64 seq, bool ack, bytes calldata result) external payable returns (bool);
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+import {Cosmos} from "./CosmosTypes.sol";
+
+interface IStakingModule {
+    event Delegate(address indexed validator, address indexed delegator, Cosmos.Coin[] amount, string newShares);
+    event Unbond(address indexed validator, address indexed delegator, Cosmos.Coin[] amount, string completionTime);
+    event Redelegate(address indexed sourceValidator, address indexed destinationValidator, Cosmos.Coin[] amount, string completionTime);
+    event CancelUnbondingDelegation(address indexed validator, address indexed delegator, Cosmos.Coin[] amount, uint64 creationHeight);
+    event WithdrawRewards(address indexed validator, address indexed delegator, Cosmos.Coin[] amount);
+    event SetWithdrawAddress(address indexed withdrawAddress);
+    function delegate(bytes calldata data) external payable returns (bytes calldata);
+    function undelegate(bytes calldata data) external payable returns (bytes calldata);
+    function redelegate(bytes calldata data) external payable returns (bytes calldata);
+    function cancelUnbondingDelegation(bytes calldata data) external payable returns (bytes calldata);
+    function withdrawDelegatorReward(bytes calldata data) external payable returns (bytes calldata);
+    function setWithdrawAddress(bytes calldata data) external payable returns (bytes calldata);
+}
@@ -0,0 +1,7 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+interface ITransferModule {
+    event TransferResult(string indexed packetSrcChannel, uint64 seq);
+    function transfer(string calldata channelID, string calldata receiver, string calldata denom, uint256 amount, uint256 timeout) external payable returns (uint64);
+}
@@ -3,19 +3,32 @@ package events
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	exec "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/exec"
+	gov "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/gov"
 	ica "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/ica"
 	relayer "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/relayer"
+	staking "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/staking"
+	transfer "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/transfer"
 	cronoseventstypes "github.com/crypto-org-chain/cronos/v2/x/cronos/events/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 var (
-	RelayerEvents        map[string]*EventDescriptor
-	IcaEvents            map[string]*EventDescriptor
+	RelayerEvents  map[string]*EventDescriptor
+	IcaEvents      map[string]*EventDescriptor
+	StakingEvents  map[string]*EventDescriptor
+	TransferEvents map[string]*EventDescriptor
+	GovEvents      map[string]*EventDescriptor
+	// ExecCosmosEvent is the generic event converted from any native event emitted by the exec precompiled contract
+	ExecCosmosEvent      abi.Event
 	RelayerValueDecoders = ValueDecoders{
 		channeltypes.AttributeKeyDataHex:             ConvertPacketData,
 		transfertypes.AttributeKeyAmount:             ConvertAmount,
@@ -43,6 +56,30 @@ var (
 		cronoseventstypes.AttributeKeySeq:   ConvertUint64,
 		channeltypes.AttributeKeySrcChannel: ReturnStringAsIs,
 	}
+	StakingValueDecoders = ValueDecoders{
+		sdk.AttributeKeyAmount:                  ConvertAmount,
+		stakingtypes.AttributeKeyValidator:      ConvertAccAddressFromBech32,
+		stakingtypes.AttributeKeyDelegator:      ConvertAccAddressFromBech32,
+		stakingtypes.AttributeKeySrcValidator:   ConvertAccAddressFromBech32,
+		stakingtypes.AttributeKeyDstValidator:   ConvertAccAddressFromBech32,
+		stakingtypes.AttributeKeyNewShares:      ReturnStringAsIs,
+		stakingtypes.AttributeKeyCompletionTime: ReturnStringAsIs,
+		stakingtypes.AttributeKeyCreationHeight: ConvertUint64,
+		distrtypes.AttributeKeyWithdrawAddress:  ConvertAccAddressFromBech32,
+	}
+	TransferValueDecoders = ValueDecoders{
+		cronoseventstypes.AttributeKeySeq:   ConvertUint64,
+		channeltypes.AttributeKeySrcChannel: ReturnStringAsIs,
+	}
+	GovValueDecoders = ValueDecoders{
+		govtypes.AttributeKeyProposalID:       ConvertUint64,
+		govtypes.AttributeKeyProposalProposer: ConvertAccAddressFromBech32,
+		govtypes.AttributeKeyProposalMessages: ReturnStringAsIs,
+		govtypes.AttributeKeyDepositor:        ConvertAccAddressFromBech32,
+		govtypes.AttributeKeyVoter:            ConvertAccAddressFromBech32,
+		govtypes.AttributeKeyOption:           ReturnStringAsIs,
+		sdk.AttributeKeyAmount:                ConvertAmount,
+	}
 )
 
 func init() {
@@ -57,6 +94,30 @@ func init() {
 		panic(err)
 	}
 	IcaEvents = NewEventDescriptors(icaABI)
+
+	var stakingABI abi.ABI
+	if err := stakingABI.UnmarshalJSON([]byte(staking.StakingModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	StakingEvents = NewEventDescriptors(stakingABI)
+
+	var transferABI abi.ABI
+	if err := transferABI.UnmarshalJSON([]byte(transfer.TransferModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	TransferEvents = NewEventDescriptors(transferABI)
+
+	var govABI abi.ABI
+	if err := govABI.UnmarshalJSON([]byte(gov.GovModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	GovEvents = NewEventDescriptors(govABI)
+
+	var execABI abi.ABI
+	if err := execABI.UnmarshalJSON([]byte(exec.ExecModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	ExecCosmosEvent = execABI.Events["CosmosEvent"]
 }
 
 func RelayerConvertEvent(event sdk.Event) (*ethtypes.Log, error) {
@@ -78,3 +139,56 @@ func IcaConvertEvent(event sdk.Event) (*ethtypes.Log, error) {
 	}
 	return desc.ConvertEvent(event.Attributes, IcaValueDecoders, map[string]string{})
 }
+
+func StakingConvertEvent(event sdk.Event) (*ethtypes.Log, error) {
+	desc, ok := StakingEvents[event.Type]
+	if !ok {
+		return nil, nil
+	}
+	return desc.ConvertEvent(event.Attributes, StakingValueDecoders, map[string]string{})
+}
+
+func TransferConvertEvent(event sdk.Event) (*ethtypes.Log, error) {
+	desc, ok := TransferEvents[event.Type]
+	if !ok {
+		return nil, nil
+	}
+	return desc.ConvertEvent(event.Attributes, TransferValueDecoders, map[string]string{})
+}
+
+func GovConvertEvent(event sdk.Event) (*ethtypes.Log, error) {
+	desc, ok := GovEvents[event.Type]
+	if !ok {
+		return nil, nil
+	}
+	// the events emitted again when the voting period starts only carry the `voting_period_start` attribute
+	for _, attr := range event.Attributes {
+		if attr.Key == govtypes.AttributeKeyProposalID {
+			return desc.ConvertEvent(event.Attributes, GovValueDecoders, map[string]string{})
+		}
+	}
+	return nil, nil
+}
+
+// ExecConvertEvent converts any native event to the generic `CosmosEvent` log, since the messages executed are not
+// known beforehand, the attributes are kept as strings.
+func ExecConvertEvent(event sdk.Event) (*ethtypes.Log, error) {
+	keys := make([]string, len(event.Attributes))
+	values := make([]string, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		keys[i] = attr.Key
+		values[i] = attr.Value
+	}
+	topics, err := abi.MakeTopics([]any{event.Type})
+	if err != nil {
+		return nil, err
+	}
+	data, err := ExecCosmosEvent.Inputs.NonIndexed().Pack(keys, values)
+	if err != nil {
+		return nil, err
+	}
+	return &ethtypes.Log{
+		Topics: []common.Hash{ExecCosmosEvent.ID, topics[0][0]},
+		Data:   data,
+	}, nil
+}
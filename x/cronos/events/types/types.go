@@ -2,6 +2,7 @@ package types
 
 const (
 	EventTypeSubmitMsgsResult  = "submit_msgs_result"
+	EventTypeTransferResult    = "transfer_result"
 	AttributeKeySeq            = "seq"
 	AttributeKeySrcPortInfo    = "packet_src_port_info"
 	AttributeKeySrcChannelInfo = "packet_src_channel_info"
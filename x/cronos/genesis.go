@@ -40,6 +40,53 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetAutoContractForDenom(ctx, m.Denom, common.HexToAddress(m.Contract))
 	}
 
+	for _, s := range genState.DisabledBridges {
+		k.SetBridgeEnabled(ctx, s.Direction, s.Denom, s.ChannelId, false)
+	}
+
+	for _, l := range genState.RateLimits {
+		k.SetRateLimit(ctx, l)
+	}
+
+	for _, r := range genState.Roles {
+		if err := k.SetRole(ctx, r); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, g := range genState.RoleGrants {
+		if err := k.GrantRole(ctx, g); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, m := range genState.MigratedContracts {
+		if !common.IsHexAddress(m.Contract) {
+			panic(fmt.Sprintf("Invalid contract address: %s", m.Contract))
+		}
+		k.SetMigratedContract(ctx, m.Denom, common.HexToAddress(m.Contract))
+	}
+
+	for _, p := range genState.ForwardedPackets {
+		k.SetForwardedPacket(ctx, p)
+	}
+
+	for _, a := range genState.BankAllowances {
+		k.SetBankAllowance(
+			ctx, common.HexToAddress(a.Token), common.HexToAddress(a.Owner), common.HexToAddress(a.Spender), a.Amount.BigInt(),
+		)
+	}
+
+	for _, h := range genState.LogHandlers {
+		if err := k.SetLogHandler(ctx, h); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, l := range genState.FailedLogs {
+		k.SetFailedLog(ctx, l)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
 
 	// this line is used by starport scaffolding # ibc/genesis/init
@@ -55,5 +102,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:            k.GetParams(ctx),
 		ExternalContracts: k.GetExternalContracts(ctx),
 		AutoContracts:     k.GetAutoContracts(ctx),
+		DisabledBridges:   k.GetDisabledBridges(ctx),
+		RateLimits:        k.GetRateLimits(ctx),
+		Roles:             k.GetCustomRoles(ctx),
+		RoleGrants:        k.GetAllRoleGrants(ctx),
+		MigratedContracts: k.GetMigratedContracts(ctx),
+		ForwardedPackets:  k.GetForwardedPackets(ctx),
+		BankAllowances:    k.GetBankAllowances(ctx),
+		LogHandlers:       k.GetLogHandlers(ctx, nil),
+		FailedLogs:        k.GetFailedLogs(ctx, nil),
 	}
 }
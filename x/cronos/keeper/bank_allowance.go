@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// GetBankAllowance returns the amount of the native coins of the token the spender can transfer on behalf of the
+// owner through the bank precompiled contract.
+func (k Keeper) GetBankAllowance(ctx sdk.Context, token, owner, spender common.Address) *big.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.BankAllowanceKey(token, owner, spender))
+	if len(bz) == 0 {
+		return new(big.Int)
+	}
+	var allowance types.BankAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+	return allowance.Amount.BigInt()
+}
+
+// SetBankAllowance sets the allowance of the native coins of the token, removes it if the amount is zero.
+func (k Keeper) SetBankAllowance(ctx sdk.Context, token, owner, spender common.Address, amount *big.Int) {
+	key := types.BankAllowanceKey(token, owner, spender)
+	if amount.Sign() <= 0 {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&types.BankAllowance{
+		Token:   token.Hex(),
+		Owner:   owner.Hex(),
+		Spender: spender.Hex(),
+		Amount:  sdkmath.NewIntFromBigInt(amount),
+	}))
+}
+
+// GetBankAllowances returns all the allowances of the bank precompiled contract
+func (k Keeper) GetBankAllowances(ctx sdk.Context) (out []types.BankAllowance) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBankAllowance).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.BankAllowance
+		k.cdc.MustUnmarshal(iter.Value(), &allowance)
+		out = append(out, allowance)
+	}
+	return
+}
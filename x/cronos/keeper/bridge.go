@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// SetBridgeEnabled turns on or off the bridge flows, unspecified direction applies to both directions,
+// empty denom or channel id applies to all of them.
+func (k Keeper) SetBridgeEnabled(ctx sdk.Context, direction types.BridgeDirection, denom, channelID string, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	for _, d := range direction.Directions() {
+		key := types.BridgeSwitchKey(d, denom, channelID)
+		if enable {
+			store.Delete(key)
+		} else {
+			store.Set(key, []byte{1})
+		}
+	}
+}
+
+// IsBridgeEnabled checks if the bridge flow is enabled, it's disabled if any of the matching switches is turned off,
+// unspecified direction checks both directions, empty channel id means the flow is not bound to a channel.
+func (k Keeper) IsBridgeEnabled(ctx sdk.Context, direction types.BridgeDirection, denom, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	for _, d := range direction.Directions() {
+		if store.Has(types.BridgeSwitchKey(d, "", "")) ||
+			store.Has(types.BridgeSwitchKey(d, denom, "")) {
+			return false
+		}
+		if channelID != "" &&
+			(store.Has(types.BridgeSwitchKey(d, "", channelID)) ||
+				store.Has(types.BridgeSwitchKey(d, denom, channelID))) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckBridgeEnabled returns error if the bridge flow is disabled, the channel id is derived from the ibc voucher
+// denom if not specified.
+func (k Keeper) CheckBridgeEnabled(ctx sdk.Context, direction types.BridgeDirection, denom, channelID string) error {
+	if channelID == "" && types.IsValidIBCDenom(denom) {
+		// ignore the error, the denom-wide switches still apply
+		channelID, _ = k.GetSourceChannelID(ctx, denom)
+	}
+	if !k.IsBridgeEnabled(ctx, direction, denom, channelID) {
+		return errors.Wrapf(types.ErrBridgeDisabled, "direction: %s, denom: %s, channel: %s", direction, denom, channelID)
+	}
+	return nil
+}
+
+// GetDisabledBridges returns all the bridge switches turned off
+func (k Keeper) GetDisabledBridges(ctx sdk.Context) (out []types.BridgeSwitch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBridgeSwitch)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, types.ParseBridgeSwitchKey(iter.Key()))
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+func (suite *KeeperTestSuite) TestBridgeSwitch() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	inbound, outbound := types.BridgeDirectionInbound, types.BridgeDirectionOutbound
+	suite.Require().True(keeper.IsBridgeEnabled(suite.ctx, types.BridgeDirectionUnspecified, CorrectIbcDenom, "channel-0"))
+
+	// per denom and channel
+	keeper.SetBridgeEnabled(suite.ctx, inbound, CorrectIbcDenom, "channel-0", false)
+	suite.Require().False(keeper.IsBridgeEnabled(suite.ctx, inbound, CorrectIbcDenom, "channel-0"))
+	suite.Require().True(keeper.IsBridgeEnabled(suite.ctx, inbound, CorrectIbcDenom, "channel-1"))
+	suite.Require().True(keeper.IsBridgeEnabled(suite.ctx, inbound, CorrectIbcDenom, ""))
+	suite.Require().True(keeper.IsBridgeEnabled(suite.ctx, outbound, CorrectIbcDenom, "channel-0"))
+	suite.Require().False(keeper.IsBridgeEnabled(suite.ctx, types.BridgeDirectionUnspecified, CorrectIbcDenom, "channel-0"))
+	keeper.SetBridgeEnabled(suite.ctx, inbound, CorrectIbcDenom, "channel-0", true)
+	suite.Require().True(keeper.IsBridgeEnabled(suite.ctx, inbound, CorrectIbcDenom, "channel-0"))
+
+	// per channel
+	keeper.SetBridgeEnabled(suite.ctx, outbound, "", "channel-1", false)
+	suite.Require().False(keeper.IsBridgeEnabled(suite.ctx, outbound, CorrectIbcDenom, "channel-1"))
+	suite.Require().False(keeper.IsBridgeEnabled(suite.ctx, outbound, CorrectCronosDenom, "channel-1"))
+	suite.Require().True(keeper.IsBridgeEnabled(suite.ctx, outbound, CorrectCronosDenom, "channel-0"))
+
+	// both directions for all
+	keeper.SetBridgeEnabled(suite.ctx, types.BridgeDirectionUnspecified, "", "", false)
+	suite.Require().False(keeper.IsBridgeEnabled(suite.ctx, inbound, CorrectCronosDenom, ""))
+	suite.Require().False(keeper.IsBridgeEnabled(suite.ctx, outbound, CorrectIbcDenom, "channel-0"))
+
+	suite.Require().Equal([]types.BridgeSwitch{
+		{Direction: inbound},
+		{Direction: outbound},
+		{Direction: outbound, ChannelId: "channel-1"},
+	}, keeper.GetDisabledBridges(suite.ctx))
+
+	keeper.SetBridgeEnabled(suite.ctx, types.BridgeDirectionUnspecified, "", "", true)
+	suite.Require().True(keeper.IsBridgeEnabled(suite.ctx, inbound, CorrectCronosDenom, ""))
+}
+
+func (suite *KeeperTestSuite) TestTurnBridge() {
+	suite.SetupTest()
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+	admin := sdk.AccAddress(suite.address.Bytes())
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	user := sdk.AccAddress(privKey.PubKey().Address())
+
+	// not authorized
+	_, err = msgServer.TurnBridge(suite.ctx, types.NewMsgTurnBridge(user.String(), false, types.BridgeDirectionInbound, "", ""))
+	suite.Require().Error(err)
+
+	_, err = msgServer.TurnBridge(suite.ctx, types.NewMsgTurnBridge(admin.String(), false, types.BridgeDirectionInbound, "", ""))
+	suite.Require().NoError(err)
+
+	// the conversion is halted
+	coins := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(123)))
+	suite.Require().NoError(suite.MintCoins(user, coins))
+	err = suite.app.CronosKeeper.ConvertVouchersToEvmCoins(suite.ctx, user.String(), coins)
+	suite.Require().ErrorIs(err, types.ErrBridgeDisabled)
+
+	rsp, err := suite.app.CronosKeeper.BridgeStatus(suite.ctx, &types.QueryBridgeStatusRequest{Direction: types.BridgeDirectionInbound})
+	suite.Require().NoError(err)
+	suite.Require().False(rsp.Enabled)
+	rsp, err = suite.app.CronosKeeper.BridgeStatus(suite.ctx, &types.QueryBridgeStatusRequest{Direction: types.BridgeDirectionOutbound})
+	suite.Require().NoError(err)
+	suite.Require().True(rsp.Enabled)
+
+	// the account with permission can resume it
+	suite.app.CronosKeeper.SetPermissions(suite.ctx, user, cronosmodulekeeper.CanTurnBridge)
+	_, err = msgServer.TurnBridge(suite.ctx, types.NewMsgTurnBridge(user.String(), true, types.BridgeDirectionUnspecified, "", ""))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.CronosKeeper.ConvertVouchersToEvmCoins(suite.ctx, user.String(), coins))
+}
@@ -19,11 +19,20 @@ import (
 // DefaultGasCap defines the gas limit used to run internal evm call
 const DefaultGasCap uint64 = 25000000
 
+// ERC165GasLimit defines the gas limit of the `supportsInterface` queries, as specified by ERC-165
+const ERC165GasLimit uint64 = 30000
+
 // CallEVM execute an evm message from native module
 func (k Keeper) CallEVM(ctx sdk.Context, to *common.Address, data []byte, value *big.Int, gasLimit uint64) (*core.Message, *evmtypes.MsgEthereumTxResponse, error) {
-	nonce := k.evmKeeper.GetNonce(ctx, types.EVMModuleAddress)
+	return k.CallEVMFrom(ctx, types.EVMModuleAddress, to, data, value, gasLimit)
+}
+
+// CallEVMFrom execute an evm message from native module in the name of the sender, the caller must make sure the
+// sender is authorized.
+func (k Keeper) CallEVMFrom(ctx sdk.Context, from common.Address, to *common.Address, data []byte, value *big.Int, gasLimit uint64) (*core.Message, *evmtypes.MsgEthereumTxResponse, error) {
+	nonce := k.evmKeeper.GetNonce(ctx, from)
 	msg := &core.Message{
-		From:              types.EVMModuleAddress,
+		From:              from,
 		To:                to,
 		Nonce:             nonce,
 		Value:             value, // amount
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,8 +15,13 @@ import (
 // LogProcessEvmHook is an evm hook that convert specific contract logs into native module calls
 type LogProcessEvmHook struct {
 	handlers map[common.Hash]types.EvmLogHandler
+	registry types.EvmLogHandlerRegistry
+	// retryQueue is nil if the failed logs are not retried
+	retryQueue *Keeper
 }
 
+var _ types.EvmLogProcessor = LogProcessEvmHook{}
+
 func NewLogProcessEvmHook(handlers ...types.EvmLogHandler) *LogProcessEvmHook {
 	handlerMap := make(map[common.Hash]types.EvmLogHandler)
 	for _, handler := range handlers {
@@ -25,25 +32,107 @@ func NewLogProcessEvmHook(handlers ...types.EvmLogHandler) *LogProcessEvmHook {
 	}
 }
 
+// WithRegistry sets the registry of the log handlers registered through governance, it's consulted for the logs not
+// processed by the compiled-in handlers.
+func (h *LogProcessEvmHook) WithRegistry(registry types.EvmLogHandlerRegistry) *LogProcessEvmHook {
+	h.registry = registry
+	return h
+}
+
+// WithRetryQueue enables the retry queue of the failed logs, when the `log_retry_window` parameter is not zero, the
+// failures of the native actions caused by the channel or bridge state are isolated from the evm tx and retried in the
+// end blocker, bounded per tx and per sender.
+func (h *LogProcessEvmHook) WithRetryQueue(k Keeper) *LogProcessEvmHook {
+	h.retryQueue = &k
+	return h
+}
+
 // PostTxProcessing implements EvmHook interface
-func (h LogProcessEvmHook) PostTxProcessing(ctx sdk.Context, _ *core.Message, receipt *ethtypes.Receipt) error {
+func (h LogProcessEvmHook) PostTxProcessing(ctx sdk.Context, msg *core.Message, receipt *ethtypes.Receipt) error {
+	var window uint64
+	if h.retryQueue != nil {
+		window = h.retryQueue.GetParams(ctx).LogRetryWindow
+	}
 	addLogToReceiptFunc := newFuncAddLogToReceipt(receipt)
-	for _, log := range receipt.Logs {
+	queued := 0
+	for i, log := range receipt.Logs {
 		if len(log.Topics) == 0 {
 			continue
 		}
-		handler, ok := h.handlers[log.Topics[0]]
-		if !ok {
+		if window == 0 {
+			if err := h.ProcessLog(ctx, log, addLogToReceiptFunc); err != nil {
+				return err
+			}
 			continue
 		}
-		err := handler.Handle(ctx, log.Address, log.Topics, log.Data, addLogToReceiptFunc)
-		if err != nil {
+		// discard the partial state changes of the failed native actions, the log is retried as a whole
+		cacheCtx, commit := ctx.CacheContext()
+		err := h.ProcessLog(cacheCtx, log, addLogToReceiptFunc)
+		if err == nil {
+			commit()
+			continue
+		}
+		// only the failures caused by the channel or bridge state are retried, and only if the tokens can be refunded
+		// at the deadline, the others revert the evm tx
+		if !types.IsRetryableLogError(err) || !h.refundable(ctx, log) {
+			return err
+		}
+		queued++
+		if queued > types.MaxFailedLogsPerTx {
+			return errors.Wrapf(
+				types.ErrTooManyFailedLogs, "tx %s has more than %d failed logs", receipt.TxHash, types.MaxFailedLogsPerTx,
+			)
+		}
+		failed := types.NewFailedLog(msg.From, receipt.TxHash, uint32(i), log, ctx.BlockHeight(), window, err)
+		if err := h.retryQueue.EnqueueFailedLog(ctx, failed); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// ProcessLog dispatches the log to the compiled-in handler of the event, or to the log handlers registered through
+// governance, the unknown logs are ignored.
+func (h LogProcessEvmHook) ProcessLog(
+	ctx sdk.Context,
+	log *ethtypes.Log,
+	addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte),
+) error {
+	if handler, ok := h.handlers[log.Topics[0]]; ok {
+		return handler.Handle(ctx, log.Address, log.Topics, log.Data, addLogToReceipt)
+	}
+	if h.registry != nil {
+		return h.registry.Handle(ctx, log.Address, log.Topics, log.Data, addLogToReceipt)
+	}
+	return nil
+}
+
+// RefundLog refunds the tokens of the log to the user, if supported by the handler of the event.
+func (h LogProcessEvmHook) RefundLog(ctx sdk.Context, log *ethtypes.Log) error {
+	refunder := h.refunder(log)
+	if refunder == nil {
+		return fmt.Errorf("refund is not supported by the handler of event %s", log.Topics[0])
+	}
+	return refunder.Refund(ctx, log.Address, log.Topics, log.Data)
+}
+
+// refundable returns if the tokens of the log can be refunded by the handler of the event.
+func (h LogProcessEvmHook) refundable(ctx sdk.Context, log *ethtypes.Log) bool {
+	refunder := h.refunder(log)
+	return refunder != nil && refunder.Refundable(ctx, log.Address, log.Topics, log.Data)
+}
+
+// refunder returns the refunder of the handler of the event, nil if not supported.
+func (h LogProcessEvmHook) refunder(log *ethtypes.Log) types.EvmLogRefunder {
+	var refunder types.EvmLogRefunder
+	if handler, ok := h.handlers[log.Topics[0]]; ok {
+		refunder, _ = handler.(types.EvmLogRefunder)
+	} else if h.registry != nil {
+		refunder, _ = h.registry.(types.EvmLogRefunder)
+	}
+	return refunder
+}
+
 // newFuncAddLogToReceipt return a function to add additional logs to the receipt
 func newFuncAddLogToReceipt(receipt *ethtypes.Receipt) func(contractAddress common.Address, logSig common.Hash, logData []byte) {
 	return func(contractAddress common.Address, logSig common.Hash, logData []byte) {
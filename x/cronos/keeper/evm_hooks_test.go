@@ -156,3 +156,68 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRegisteredLogHandlers() {
+	suite.SetupTest()
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+	authority := suite.app.CronosKeeper.GetAuthority()
+	admin := sdk.AccAddress(suite.address.Bytes())
+
+	contract := common.BigToAddress(big.NewInt(1))
+	recipient := common.BigToAddress(big.NewInt(3))
+	handler := types.LogHandler{
+		Contract: contract.Hex(),
+		Event: `{"type":"event","name":"Unwrap","inputs":[` +
+			`{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}`,
+		Actions: []types.LogHandlerActionBinding{{
+			Action: types.LogHandlerActionSendToAccount,
+			Params: []types.LogParamBinding{
+				{Param: types.LogParamRecipient, Argument: "to"},
+				{Param: types.LogParamAmount, Argument: "value"},
+			},
+		}},
+	}
+	event, err := handler.ParseEvent()
+	suite.Require().NoError(err)
+
+	suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, denom, contract)
+	coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin)))
+
+	data, err := event.Inputs.NonIndexed().Pack(coin.Amount.BigInt())
+	suite.Require().NoError(err)
+	receipt := func() *ethtypes.Receipt {
+		return &ethtypes.Receipt{
+			Logs: []*ethtypes.Log{{
+				Address: contract,
+				Topics:  []common.Hash{event.ID, common.BytesToHash(recipient.Bytes())},
+				Data:    data,
+			}},
+		}
+	}
+
+	// unregistered logs are ignored
+	suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, nil, receipt()))
+	suite.Require().Equal(coin, suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(contract.Bytes()), denom))
+
+	// only governance is authorized
+	_, err = msgServer.SetLogHandler(suite.ctx, types.NewMsgSetLogHandler(admin.String(), handler))
+	suite.Require().Error(err)
+	_, err = msgServer.SetLogHandler(suite.ctx, types.NewMsgSetLogHandler(authority, handler))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.LogHandler{handler}, suite.app.CronosKeeper.GetLogHandlers(suite.ctx, &contract))
+
+	suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, nil, receipt()))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(contract.Bytes()), denom).IsZero())
+	suite.Require().Equal(coin, suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(recipient.Bytes()), denom))
+
+	// not enough balance, expect fail
+	suite.Require().Error(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, nil, receipt()))
+
+	_, err = msgServer.RemoveLogHandler(suite.ctx, types.NewMsgRemoveLogHandler(authority, contract.Hex(), common.Hash{}.Hex()))
+	suite.Require().Error(err)
+	_, err = msgServer.RemoveLogHandler(suite.ctx, types.NewMsgRemoveLogHandler(authority, contract.Hex(), event.ID.Hex()))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.CronosKeeper.GetLogHandlers(suite.ctx, nil))
+	suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, nil, receipt()))
+}
@@ -0,0 +1,157 @@
+package evmhandler
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cronoskeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+var (
+	_ types.EvmLogHandlerRegistry = RegisteredLogHandlers{}
+	_ types.EvmLogRefunder        = RegisteredLogHandlers{}
+)
+
+// RegisteredLogHandlers processes the logs with the log handlers registered through governance, the event arguments
+// are bound to the params of the native actions by the log handler.
+type RegisteredLogHandlers struct {
+	cronosKeeper  cronoskeeper.Keeper
+	sendToAccount SendToAccountHandler
+	sendToIbc     SendToIbcHandler
+}
+
+func NewRegisteredLogHandlers(bankKeeper types.BankKeeper, cronosKeeper cronoskeeper.Keeper) *RegisteredLogHandlers {
+	return &RegisteredLogHandlers{
+		cronosKeeper:  cronosKeeper,
+		sendToAccount: *NewSendToAccountHandler(bankKeeper, cronosKeeper),
+		sendToIbc:     *NewSendToIbcHandler(bankKeeper, cronosKeeper),
+	}
+}
+
+func (h RegisteredLogHandlers) Handle(
+	ctx sdk.Context,
+	contract common.Address,
+	topics []common.Hash,
+	data []byte,
+	_ func(contractAddress common.Address, logSig common.Hash, logData []byte),
+) error {
+	handler, found := h.cronosKeeper.GetLogHandler(ctx, contract, topics[0])
+	if !found {
+		return nil
+	}
+	args, err := decodeLog(handler, topics, data)
+	if err != nil {
+		// log and ignore
+		h.cronosKeeper.Logger(ctx).Error("log handler matches but failed to decode", "contract", contract, "error", err)
+		return nil
+	}
+
+	for _, action := range handler.Actions {
+		params := bindParams(action, args)
+		switch action.Action {
+		case types.LogHandlerActionSendToAccount:
+			err = h.sendToAccount.handle(
+				ctx, contract,
+				params[types.LogParamRecipient].(common.Address),
+				params[types.LogParamAmount].(*big.Int),
+			)
+		case types.LogHandlerActionSendToIbc:
+			channelID, _ := params[types.LogParamChannelID].(*big.Int)
+			memo, _ := params[types.LogParamMemo].(string)
+			err = h.sendToIbc.handle(
+				ctx, contract,
+				params[types.LogParamSender].(common.Address),
+				params[types.LogParamRecipient].(string),
+				params[types.LogParamAmount].(*big.Int),
+				channelID, memo,
+			)
+		default:
+			err = fmt.Errorf("invalid log handler action: %s", action.Action)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Refundable implements `EvmLogRefunder`, only the `LogHandlerActionSendToIbc` actions are refundable, the
+// `LogHandlerActionSendToAccount` actions are not, so the logs with them are not retried.
+func (h RegisteredLogHandlers) Refundable(ctx sdk.Context, contract common.Address, topics []common.Hash, _ []byte) bool {
+	handler, found := h.cronosKeeper.GetLogHandler(ctx, contract, topics[0])
+	return found && isRefundable(handler)
+}
+
+// Refund releases the native tokens of the `LogHandlerActionSendToIbc` actions to the senders without the ibc
+// transfers, it fails if any action is not refundable.
+func (h RegisteredLogHandlers) Refund(ctx sdk.Context, contract common.Address, topics []common.Hash, data []byte) error {
+	handler, found := h.cronosKeeper.GetLogHandler(ctx, contract, topics[0])
+	if !found {
+		return fmt.Errorf("log handler not found, contract: %s, event: %s", contract, topics[0])
+	}
+	if !isRefundable(handler) {
+		return fmt.Errorf("log handler is not refundable, contract: %s, event: %s", contract, topics[0])
+	}
+	args, err := decodeLog(handler, topics, data)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range handler.Actions {
+		params := bindParams(action, args)
+		if _, err := h.sendToIbc.release(
+			ctx, contract,
+			params[types.LogParamSender].(common.Address),
+			params[types.LogParamAmount].(*big.Int),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isRefundable returns if all the actions of the log handler are refundable.
+func isRefundable(handler types.LogHandler) bool {
+	for _, action := range handler.Actions {
+		if action.Action != types.LogHandlerActionSendToIbc {
+			return false
+		}
+	}
+	return len(handler.Actions) > 0
+}
+
+// bindParams returns the params of the action bound to the decoded arguments of the event.
+func bindParams(action types.LogHandlerActionBinding, args map[string]interface{}) map[string]interface{} {
+	params := make(map[string]interface{}, len(action.Params))
+	for _, binding := range action.Params {
+		params[binding.Param] = args[binding.Argument]
+	}
+	return params
+}
+
+// decodeLog decodes both the indexed and the non-indexed arguments of the event.
+func decodeLog(handler types.LogHandler, topics []common.Hash, data []byte) (map[string]interface{}, error) {
+	event, err := handler.ParseEvent()
+	if err != nil {
+		return nil, err
+	}
+	args := make(map[string]interface{}, len(event.Inputs))
+	if err := event.Inputs.UnpackIntoMap(args, data); err != nil {
+		return nil, err
+	}
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, topics[1:]); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
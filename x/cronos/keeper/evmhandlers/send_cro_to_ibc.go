@@ -12,7 +12,10 @@ import (
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
 
-var _ types.EvmLogHandler = SendCroToIbcHandler{}
+var (
+	_ types.EvmLogHandler  = SendCroToIbcHandler{}
+	_ types.EvmLogRefunder = SendCroToIbcHandler{}
+)
 
 const SendCroToIbcEventName = "__CronosSendCroToIbc"
 
@@ -92,3 +95,20 @@ func (h SendCroToIbcHandler) Handle(
 	}
 	return nil
 }
+
+// Refundable implements `EvmLogRefunder`, the ibc transfer is the only action.
+func (h SendCroToIbcHandler) Refundable(sdk.Context, common.Address, []common.Hash, []byte) bool {
+	return true
+}
+
+// Refund transfers the gas tokens of the contract to the sender without the ibc transfer.
+func (h SendCroToIbcHandler) Refund(ctx sdk.Context, contract common.Address, _ []common.Hash, data []byte) error {
+	unpacked, err := SendCroToIbcEvent.Inputs.Unpack(data)
+	if err != nil {
+		return err
+	}
+	sender := sdk.AccAddress(unpacked[0].(common.Address).Bytes())
+	amount := sdkmath.NewIntFromBigInt(unpacked[2].(*big.Int))
+	coins := sdk.NewCoins(sdk.NewCoin(h.cronosKeeper.GetEvmParams(ctx).EvmDenom, amount))
+	return h.bankKeeper.SendCoins(ctx, sdk.AccAddress(contract.Bytes()), sender, coins)
+}
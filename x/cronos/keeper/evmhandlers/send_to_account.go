@@ -71,19 +71,27 @@ func (h SendToAccountHandler) Handle(
 		h.cronosKeeper.Logger(ctx).Error("log signature matches but failed to decode", "error", err)
 		return nil
 	}
+	recipient := unpacked[0].(common.Address)
+	amount := unpacked[1].(*big.Int)
+	return h.handle(ctx, contract, recipient, amount)
+}
 
+func (h SendToAccountHandler) handle(
+	ctx sdk.Context,
+	contract common.Address,
+	recipientAddress common.Address,
+	amount *big.Int,
+) error {
 	denom, found := h.cronosKeeper.GetDenomByContract(ctx, contract)
 	if !found {
 		return fmt.Errorf("contract %s is not connected to native token", contract)
 	}
-
-	contractAddr := sdk.AccAddress(contract.Bytes())
-	recipient := sdk.AccAddress(unpacked[0].(common.Address).Bytes())
-	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(unpacked[1].(*big.Int))))
-	err = h.bankKeeper.SendCoins(ctx, contractAddr, recipient, coins)
-	if err != nil {
+	if err := h.cronosKeeper.CheckBridgeEnabled(ctx, types.BridgeDirectionOutbound, denom, ""); err != nil {
 		return err
 	}
 
-	return nil
+	contractAddr := sdk.AccAddress(contract.Bytes())
+	recipient := sdk.AccAddress(recipientAddress.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+	return h.bankKeeper.SendCoins(ctx, contractAddr, recipient, coins)
 }
@@ -13,7 +13,10 @@ import (
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
 
-var _ types.EvmLogHandler = SendToIbcHandler{}
+var (
+	_ types.EvmLogHandler  = SendToIbcHandler{}
+	_ types.EvmLogRefunder = SendToIbcHandler{}
+)
 
 const SendToIbcEventName = "__CronosSendToIbc"
 
@@ -79,7 +82,22 @@ func (h SendToIbcHandler) Handle(
 	sender := unpacked[0].(common.Address)
 	recipient := unpacked[1].(string)
 	amount := unpacked[2].(*big.Int)
-	return h.handle(ctx, contract, sender, recipient, amount, nil)
+	return h.handle(ctx, contract, sender, recipient, amount, nil, "")
+}
+
+// Refundable implements `EvmLogRefunder`, the ibc transfer is the only action.
+func (h SendToIbcHandler) Refundable(sdk.Context, common.Address, []common.Hash, []byte) bool {
+	return true
+}
+
+// Refund releases the native tokens to the sender without the ibc transfer.
+func (h SendToIbcHandler) Refund(ctx sdk.Context, contract common.Address, _ []common.Hash, data []byte) error {
+	unpacked, err := SendToIbcEvent.Inputs.Unpack(data)
+	if err != nil {
+		return err
+	}
+	_, err = h.release(ctx, contract, unpacked[0].(common.Address), unpacked[2].(*big.Int))
+	return err
 }
 
 func (h SendToIbcHandler) handle(
@@ -89,14 +107,37 @@ func (h SendToIbcHandler) handle(
 	recipient string,
 	amountInt *big.Int,
 	id *big.Int,
+	memo string,
 ) error {
+	coins, err := h.release(ctx, contract, senderAddress, amountInt)
+	if err != nil {
+		return err
+	}
+
+	channelId := ""
+	if id != nil {
+		channelId = "channel-" + id.String()
+	}
+	// Initiate IBC transfer from sender account
+	sender := sdk.AccAddress(senderAddress.Bytes())
+	return h.cronosKeeper.IbcTransferCoinsWithMemo(ctx, sender.String(), recipient, coins, channelId, memo)
+}
+
+// release transfers the native tokens of the contract to the sender, which is the refunded address if the ibc
+// transfer fails.
+func (h SendToIbcHandler) release(
+	ctx sdk.Context,
+	contract common.Address,
+	senderAddress common.Address,
+	amountInt *big.Int,
+) (sdk.Coins, error) {
 	denom, found := h.cronosKeeper.GetDenomByContract(ctx, contract)
 	if !found {
-		return fmt.Errorf("contract %s is not connected to native token", contract)
+		return nil, fmt.Errorf("contract %s is not connected to native token", contract)
 	}
 
 	if !types.IsValidIBCDenom(denom) && !types.IsValidCronosDenom(denom) {
-		return fmt.Errorf("the native token associated with the contract %s is neither an ibc voucher or a cronos token", contract)
+		return nil, fmt.Errorf("the native token associated with the contract %s is neither an ibc voucher or a cronos token", contract)
 	}
 
 	contractAddr := sdk.AccAddress(contract.Bytes())
@@ -104,30 +145,20 @@ func (h SendToIbcHandler) handle(
 	amount := sdkmath.NewIntFromBigInt(amountInt)
 	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
 
-	var err error
 	if types.IsSourceCoin(denom) {
 		// it is a source token, we need to mint coins
-		if err = h.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-			return err
+		if err := h.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return nil, err
 		}
 		// send the coin to the user
-		if err = h.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
-			return err
+		if err := h.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+			return nil, err
 		}
 	} else {
 		// First, transfer IBC coin to user so that he will be the refunded address if transfer fails
-		if err = h.bankKeeper.SendCoins(ctx, contractAddr, sender, coins); err != nil {
-			return err
+		if err := h.bankKeeper.SendCoins(ctx, contractAddr, sender, coins); err != nil {
+			return nil, err
 		}
 	}
-
-	channelId := ""
-	if id != nil {
-		channelId = "channel-" + id.String()
-	}
-	// Initiate IBC transfer from sender account
-	if err = h.cronosKeeper.IbcTransferCoins(ctx, sender.String(), recipient, coins, channelId); err != nil {
-		return err
-	}
-	return nil
+	return coins, nil
 }
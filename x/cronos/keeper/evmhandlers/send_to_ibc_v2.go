@@ -12,7 +12,10 @@ import (
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
 
-var _ types.EvmLogHandler = SendToIbcV2Handler{}
+var (
+	_ types.EvmLogHandler  = SendToIbcV2Handler{}
+	_ types.EvmLogRefunder = SendToIbcV2Handler{}
+)
 
 // SendToIbcEventV2 represent the signature of
 // `event __CronosSendToIbc(address indexed sender, string indexed recipient, string indexed channel_id, uint256 amount, bytes extraData)`
@@ -95,7 +98,32 @@ func (h SendToIbcV2Handler) Handle(
 	channelId := new(big.Int).SetBytes(topics[2].Bytes())
 	recipient := unpacked[0].(string)
 	amount := unpacked[1].(*big.Int)
-	// extraData := unpacked[2].([]byte)
+	extraData := unpacked[2].([]byte)
+
+	// only the extra data carrying valid forward metadata is attached to the packet as the memo, so the tokens are
+	// routed to another chain by the destination chain, other extra data is ignored as before, including the malformed
+	// forward metadata, which is logged rather than failing the transfer.
+	memo := string(extraData)
+	if _, found, err := types.ParseForwardMetadata(memo); err != nil {
+		h.cronosKeeper.Logger(ctx).Info("ignore the invalid forward metadata in extra data", "error", err)
+		memo = ""
+	} else if !found {
+		memo = ""
+	}
 
-	return h.handle(ctx, contract, sender, recipient, amount, channelId)
+	return h.handle(ctx, contract, sender, recipient, amount, channelId, memo)
+}
+
+// Refund releases the native tokens to the sender without the ibc transfer.
+func (h SendToIbcV2Handler) Refund(ctx sdk.Context, contract common.Address, topics []common.Hash, data []byte) error {
+	if len(topics) != 3 {
+		return fmt.Errorf("wrong number of indexed events: %d", len(topics))
+	}
+	unpacked, err := SendToIbcEventV2.Inputs.Unpack(data)
+	if err != nil {
+		return err
+	}
+	sender := common.BytesToAddress(topics[1].Bytes())
+	_, err = h.release(ctx, contract, sender, unpacked[1].(*big.Int))
+	return err
 }
@@ -308,6 +308,52 @@ func (suite *KeeperTestSuite) TestSendToIbcV2Handler() {
 			func() {},
 			nil,
 		},
+		{
+			"invalid forward metadata is ignored",
+			func() {
+				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, validDenom, contract)
+				coin := sdk.NewCoin(validDenom, sdkmath.NewInt(100))
+				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
+				suite.Require().NoError(err)
+
+				topics = []common.Hash{
+					evmhandlers.SendToIbcEvent.ID,
+					sender.Hash(),
+					common.BytesToHash(big.NewInt(0).Bytes()),
+				}
+				input, _ := evmhandlers.SendToIbcEventV2.Inputs.NonIndexed().Pack(
+					recipient,
+					coin.Amount.BigInt(),
+					[]byte(`{"forward": {"channel": "channel-1"}}`),
+				)
+				data = input
+			},
+			func() {},
+			nil,
+		},
+		{
+			"success send to ibc with forward metadata",
+			func() {
+				suite.app.CronosKeeper.SetExternalContractForDenom(suite.ctx, validDenom, contract)
+				coin := sdk.NewCoin(validDenom, sdkmath.NewInt(100))
+				err := suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin))
+				suite.Require().NoError(err)
+
+				topics = []common.Hash{
+					evmhandlers.SendToIbcEvent.ID,
+					sender.Hash(),
+					common.BytesToHash(big.NewInt(0).Bytes()),
+				}
+				input, _ := evmhandlers.SendToIbcEventV2.Inputs.NonIndexed().Pack(
+					recipient,
+					coin.Amount.BigInt(),
+					[]byte(`{"forward": {"receiver": "osmo1receiver", "channel": "channel-1"}}`),
+				)
+				data = input
+			},
+			func() {},
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
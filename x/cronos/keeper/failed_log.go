@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// SetFailedLog stores the failed log and schedules the next attempt.
+func (k Keeper) SetFailedLog(ctx sdk.Context, l types.FailedLog) {
+	store := ctx.KVStore(k.storeKey)
+	txHash := common.HexToHash(l.TxHash)
+	key := types.FailedLogKey(txHash, l.LogIndex)
+	store.Set(key, k.cdc.MustMarshal(&l))
+	store.Set(types.FailedLogQueueKey(l.NextRetryHeight, txHash, l.LogIndex), key)
+	store.Set(types.FailedLogSenderKey(common.HexToAddress(l.Sender), txHash, l.LogIndex), key)
+}
+
+// EnqueueFailedLog puts a log of which the first attempt failed into the retry queue, it fails if the sender already
+// has `MaxFailedLogsPerSender` logs in the queue.
+func (k Keeper) EnqueueFailedLog(ctx sdk.Context, l types.FailedLog) error {
+	sender := common.HexToAddress(l.Sender)
+	if k.countFailedLogsOfSender(ctx, sender, types.MaxFailedLogsPerSender) >= types.MaxFailedLogsPerSender {
+		return errors.Wrapf(types.ErrTooManyFailedLogs, "sender %s has %d failed logs", l.Sender, types.MaxFailedLogsPerSender)
+	}
+	k.SetFailedLog(ctx, l)
+	k.Logger(ctx).Info("native actions of evm log failed, retry later",
+		"tx", l.TxHash, "index", l.LogIndex, "deadline", l.DeadlineHeight, "error", l.Error)
+	ctx.EventManager().EmitEvent(types.NewFailedLogEvent(types.EventTypeLogRetryQueued, l, l.Error))
+	return nil
+}
+
+// countFailedLogsOfSender counts the failed logs of the sender in the retry queue, up to the limit.
+func (k Keeper) countFailedLogsOfSender(ctx sdk.Context, sender common.Address, limit int) int {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.FailedLogSenderPrefix(sender)).Iterator(nil, nil)
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid() && count < limit; iter.Next() {
+		count++
+	}
+	return count
+}
+
+// GetFailedLog returns the failed log in the retry queue.
+func (k Keeper) GetFailedLog(ctx sdk.Context, txHash common.Hash, logIndex uint32) (types.FailedLog, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FailedLogKey(txHash, logIndex))
+	if bz == nil {
+		return types.FailedLog{}, false
+	}
+	var l types.FailedLog
+	k.cdc.MustUnmarshal(bz, &l)
+	return l, true
+}
+
+// GetFailedLogs returns the failed logs of the tx, or all of them if the tx hash is nil.
+func (k Keeper) GetFailedLogs(ctx sdk.Context, txHash *common.Hash) (out []types.FailedLog) {
+	prefixKey := types.KeyPrefixFailedLog
+	if txHash != nil {
+		prefixKey = append(append([]byte{}, prefixKey...), txHash.Bytes()...)
+	}
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var l types.FailedLog
+		k.cdc.MustUnmarshal(iter.Value(), &l)
+		out = append(out, l)
+	}
+	return out
+}
+
+func (k Keeper) removeFailedLog(ctx sdk.Context, l types.FailedLog) {
+	store := ctx.KVStore(k.storeKey)
+	txHash := common.HexToHash(l.TxHash)
+	store.Delete(types.FailedLogKey(txHash, l.LogIndex))
+	store.Delete(types.FailedLogQueueKey(l.NextRetryHeight, txHash, l.LogIndex))
+	store.Delete(types.FailedLogSenderKey(common.HexToAddress(l.Sender), txHash, l.LogIndex))
+}
+
+// RetryFailedLogs is called in the end blocker to retry the failed logs due, the ones still failing at the deadline
+// are refunded to the user and removed from the retry queue, at most `MaxFailedLogsPerBlock` logs are processed in a
+// block, the rest are postponed to the next one.
+func (k Keeper) RetryFailedLogs(ctx sdk.Context) {
+	if k.logProcessor == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	end := types.FailedLogQueueKey(ctx.BlockHeight()+1, common.Hash{}, 0)
+	iter := store.Iterator(types.KeyPrefixFailedLogQueue, end)
+	var due []types.FailedLog
+	for ; iter.Valid() && len(due) < types.MaxFailedLogsPerBlock; iter.Next() {
+		var l types.FailedLog
+		k.cdc.MustUnmarshal(store.Get(iter.Value()), &l)
+		due = append(due, l)
+	}
+	iter.Close()
+
+	for _, l := range due {
+		k.removeFailedLog(ctx, l)
+		if ctx.BlockHeight() < l.DeadlineHeight {
+			k.retryFailedLog(ctx, l)
+		} else {
+			k.refundFailedLog(ctx, l)
+		}
+	}
+}
+
+func (k Keeper) retryFailedLog(ctx sdk.Context, l types.FailedLog) {
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.logProcessor.ProcessLog(cacheCtx, l.EthLog(), noopAddLogToReceipt); err != nil {
+		l.Fail(ctx.BlockHeight(), err)
+		k.SetFailedLog(ctx, l)
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvent(types.NewFailedLogEvent(types.EventTypeLogRetried, l, ""))
+}
+
+func (k Keeper) refundFailedLog(ctx sdk.Context, l types.FailedLog) {
+	var errStr string
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.logProcessor.RefundLog(cacheCtx, l.EthLog()); err != nil {
+		k.Logger(ctx).Error("failed to refund the failed evm log", "tx", l.TxHash, "index", l.LogIndex, "error", err)
+		errStr = err.Error()
+	} else {
+		commit()
+	}
+	ctx.EventManager().EmitEvent(types.NewFailedLogEvent(types.EventTypeLogRefunded, l, errStr))
+}
+
+// noopAddLogToReceipt ignores the logs added by the handlers when retried, since the receipt is already finalized.
+func noopAddLogToReceipt(common.Address, common.Hash, []byte) {}
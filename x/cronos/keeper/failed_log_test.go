@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	handlers "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/evmhandlers"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// succeedingLogProcessor processes any log successfully, to simulate the recovery of the channel or bridge state.
+type succeedingLogProcessor struct{}
+
+func (succeedingLogProcessor) ProcessLog(sdk.Context, *ethtypes.Log, func(common.Address, common.Hash, []byte)) error {
+	return nil
+}
+
+func (succeedingLogProcessor) RefundLog(sdk.Context, *ethtypes.Log) error {
+	return errors.New("not refundable")
+}
+
+func (suite *KeeperTestSuite) TestFailedLogs() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	contract := common.BigToAddress(big.NewInt(1))
+	sender := common.BigToAddress(big.NewInt(4))
+	msg := &core.Message{From: sender}
+	window := uint64(10)
+	height := suite.ctx.BlockHeight()
+
+	params := keeper.GetParams(suite.ctx)
+	params.LogRetryWindow = window
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	trace := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "basetcro"}
+	suite.app.TransferKeeper.SetDenomTrace(suite.ctx, trace)
+	ibcDenom := trace.IBCDenom()
+	keeper.SetExternalContractForDenom(suite.ctx, ibcDenom, contract)
+
+	// the failures not caused by the channel or bridge state revert the evm tx, like the insufficient balance
+	recipient := common.BigToAddress(big.NewInt(3))
+	data, err := handlers.SendToAccountEvent.Inputs.NonIndexed().Pack(recipient, big.NewInt(1000))
+	suite.Require().NoError(err)
+	receipt := &ethtypes.Receipt{
+		TxHash: common.BigToHash(big.NewInt(100)),
+		Logs: []*ethtypes.Log{{
+			Address: contract,
+			Topics:  []common.Hash{handlers.SendToAccountEvent.ID},
+			Data:    data,
+		}},
+	}
+	suite.Require().Error(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+	suite.Require().Empty(keeper.GetFailedLogs(suite.ctx, nil))
+
+	// the ibc transfers fail while the bridge of the channel is disabled
+	keeper.SetBridgeEnabled(suite.ctx, types.BridgeDirectionOutbound, "", "channel-0", false)
+	coin := sdk.NewCoin(ibcDenom, sdkmath.NewInt(100))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin)))
+	data, err = handlers.SendToIbcEvent.Inputs.NonIndexed().Pack(sender, "recipient", coin.Amount.BigInt())
+	suite.Require().NoError(err)
+	sendToIbcLog := func() *ethtypes.Log {
+		return &ethtypes.Log{
+			Address: contract,
+			Topics:  []common.Hash{handlers.SendToIbcEvent.ID},
+			Data:    data,
+		}
+	}
+
+	txHash := common.BigToHash(big.NewInt(1))
+	receipt = &ethtypes.Receipt{TxHash: txHash, Logs: []*ethtypes.Log{sendToIbcLog()}}
+	suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+	// the partial state changes are discarded
+	suite.Require().Equal(coin, suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(contract.Bytes()), ibcDenom))
+	failed := keeper.GetFailedLogs(suite.ctx, &txHash)
+	suite.Require().Len(failed, 1)
+	suite.Require().Equal(uint32(1), failed[0].Attempts)
+	suite.Require().Equal(height+1, failed[0].NextRetryHeight)
+	suite.Require().Equal(height+int64(window), failed[0].DeadlineHeight)
+	suite.Require().Equal(sender.Hex(), failed[0].Sender)
+
+	// not due yet
+	keeper.RetryFailedLogs(suite.ctx)
+	suite.Require().Len(keeper.GetFailedLogs(suite.ctx, nil), 1)
+
+	// still failing, retried with backoff
+	suite.ctx = suite.ctx.WithBlockHeight(height + 1)
+	keeper.RetryFailedLogs(suite.ctx)
+	l, found := keeper.GetFailedLog(suite.ctx, txHash, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(2), l.Attempts)
+	suite.Require().Equal(height+3, l.NextRetryHeight)
+
+	for h := height + 3; h < height+int64(window); h++ {
+		suite.ctx = suite.ctx.WithBlockHeight(h)
+		keeper.RetryFailedLogs(suite.ctx)
+		suite.Require().Len(keeper.GetFailedLogs(suite.ctx, &txHash), 1)
+	}
+	l, found = keeper.GetFailedLog(suite.ctx, txHash, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(4), l.Attempts)
+	suite.Require().Equal(l.DeadlineHeight, l.NextRetryHeight)
+
+	// refunded to the sender at the deadline
+	suite.ctx = suite.ctx.WithBlockHeight(height + int64(window))
+	keeper.RetryFailedLogs(suite.ctx)
+	suite.Require().Empty(keeper.GetFailedLogs(suite.ctx, nil))
+	suite.Require().Equal(coin, suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(sender.Bytes()), ibcDenom))
+
+	// the failures of the handlers which can't refund the tokens revert the evm tx rather than being queued, even if
+	// caused by the bridge state, like the send to account while the bridge of the channel of the denom is disabled.
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin)))
+	sendToAccountData, err := handlers.SendToAccountEvent.Inputs.NonIndexed().Pack(recipient, coin.Amount.BigInt())
+	suite.Require().NoError(err)
+	sendToAccountLog := &ethtypes.Log{
+		Address: contract,
+		Topics:  []common.Hash{handlers.SendToAccountEvent.ID},
+		Data:    sendToAccountData,
+	}
+	receipt = &ethtypes.Receipt{TxHash: common.BigToHash(big.NewInt(3)), Logs: []*ethtypes.Log{sendToAccountLog}}
+	err = suite.app.EvmKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().Error(err)
+	suite.Require().True(types.IsRetryableLogError(err))
+	suite.Require().Empty(keeper.GetFailedLogs(suite.ctx, nil))
+
+	// such a log is dropped at the deadline without moving the tokens if queued anyway
+	height = suite.ctx.BlockHeight()
+	failedTxHash := common.BigToHash(big.NewInt(3))
+	suite.Require().NoError(keeper.EnqueueFailedLog(suite.ctx,
+		types.NewFailedLog(sender, failedTxHash, 0, sendToAccountLog, height, 1, err)))
+	suite.ctx = suite.ctx.WithBlockHeight(height + 1).WithEventManager(sdk.NewEventManager())
+	keeper.RetryFailedLogs(suite.ctx)
+	suite.Require().Empty(keeper.GetFailedLogs(suite.ctx, nil))
+	suite.Require().Equal(coin, suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(contract.Bytes()), ibcDenom))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(recipient.Bytes()), ibcDenom).IsZero())
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeLogRefunded, events[0].Type)
+	errAttr, found := events[0].GetAttribute(types.AttributeKeyError)
+	suite.Require().True(found)
+	suite.Require().NotEmpty(errAttr.Value)
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(
+		suite.ctx, sdk.AccAddress(contract.Bytes()), sdk.AccAddress(recipient.Bytes()), sdk.NewCoins(coin),
+	))
+
+	// the log is removed from the queue once the retry succeeds
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(contract.Bytes()), sdk.NewCoins(coin)))
+	height = suite.ctx.BlockHeight()
+	txHash = common.BigToHash(big.NewInt(2))
+	receipt = &ethtypes.Receipt{TxHash: txHash, Logs: []*ethtypes.Log{sendToIbcLog()}}
+	suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+	suite.Require().Len(keeper.GetFailedLogs(suite.ctx, &txHash), 1)
+	suite.ctx = suite.ctx.WithBlockHeight(height + 1)
+	recovered := keeper
+	recovered.SetLogProcessor(succeedingLogProcessor{})
+	recovered.RetryFailedLogs(suite.ctx)
+	suite.Require().Empty(keeper.GetFailedLogs(suite.ctx, nil))
+
+	// the number of the failed logs of a tx is bounded
+	data, err = handlers.SendToIbcEvent.Inputs.NonIndexed().Pack(sender, "recipient", big.NewInt(1))
+	suite.Require().NoError(err)
+	logs := make([]*ethtypes.Log, types.MaxFailedLogsPerTx+1)
+	for i := range logs {
+		logs[i] = sendToIbcLog()
+	}
+	cacheCtx, _ := suite.ctx.CacheContext()
+	receipt = &ethtypes.Receipt{TxHash: common.BigToHash(big.NewInt(4)), Logs: logs}
+	err = suite.app.EvmKeeper.PostTxProcessing(cacheCtx, msg, receipt)
+	suite.Require().ErrorIs(err, types.ErrTooManyFailedLogs)
+
+	// the number of the failed logs of a sender is bounded
+	for i := 0; i < types.MaxFailedLogsPerSender/types.MaxFailedLogsPerTx; i++ {
+		receipt = &ethtypes.Receipt{TxHash: common.BigToHash(big.NewInt(int64(10 + i))), Logs: logs[:types.MaxFailedLogsPerTx]}
+		suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+	}
+	suite.Require().Len(keeper.GetFailedLogs(suite.ctx, nil), types.MaxFailedLogsPerSender)
+	receipt = &ethtypes.Receipt{TxHash: common.BigToHash(big.NewInt(20)), Logs: logs[:1]}
+	err = suite.app.EvmKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().ErrorIs(err, types.ErrTooManyFailedLogs)
+	// other senders are not affected
+	suite.Require().NoError(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, &core.Message{From: recipient}, receipt))
+
+	// the failures revert the evm tx when the retry queue is disabled
+	params.LogRetryWindow = 0
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	receipt = &ethtypes.Receipt{TxHash: common.BigToHash(big.NewInt(21)), Logs: logs[:1]}
+	suite.Require().Error(suite.app.EvmKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+
+	_, err = keeper.FailedLogs(suite.ctx, &types.QueryFailedLogsRequest{TxHash: "0x1234"})
+	suite.Require().Error(err)
+}
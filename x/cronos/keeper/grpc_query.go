@@ -6,10 +6,12 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -119,30 +121,65 @@ func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*t
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
-// Permissions returns the permissions of a specific account
+// Permissions returns the permissions and role grants of a specific account, or the role grants of all the accounts
+// if the address is empty.
 func (k Keeper) Permissions(goCtx context.Context, req *types.QueryPermissionsRequest) (*types.QueryPermissionsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	acc, err := sdk.AccAddressFromBech32(req.Address)
+	var rsp types.QueryPermissionsResponse
+	storePrefix := types.KeyPrefixRoleGrant
+	if req.Address != "" {
+		acc, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		accounts := []sdk.AccAddress{acc}
+		rsp.CanChangeTokenMapping = k.IsAuthorized(ctx, accounts, sdk.MsgTypeURL(&types.MsgUpdateTokenMapping{}))
+		rsp.CanTurnBridge = k.IsAuthorized(ctx, accounts, sdk.MsgTypeURL(&types.MsgTurnBridge{}))
+		storePrefix = types.RoleGrantsPrefix(acc)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var grant types.RoleGrant
+		if err := k.cdc.Unmarshal(value, &grant); err != nil {
+			return err
+		}
+		rsp.Grants = append(rsp.Grants, grant)
+		return nil
+	})
 	if err != nil {
-		return nil, err
-	}
-	admin := k.GetParams(ctx).CronosAdmin
-	if admin == acc.String() {
-		return &types.QueryPermissionsResponse{
-			CanChangeTokenMapping: true,
-			CanTurnBridge:         true,
-		}, nil
-	}
-	permissions := k.GetPermissions(ctx, acc)
-	return &types.QueryPermissionsResponse{
-		CanChangeTokenMapping: CanChangeTokenMapping == (permissions & CanChangeTokenMapping),
-		CanTurnBridge:         CanTurnBridge == (permissions & CanTurnBridge),
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rsp.Pagination = pageRes
+	return &rsp, nil
+}
+
+// Roles returns all the roles, including the built-in ones
+func (k Keeper) Roles(goCtx context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryRolesResponse{
+		Roles: k.GetRoles(ctx),
 	}, nil
 }
 
+// TokenSupplies checks the supplies of the CRC21 tokens against the native coins backing them
+func (k Keeper) TokenSupplies(goCtx context.Context, req *types.QueryTokenSuppliesRequest) (*types.QueryTokenSuppliesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := &types.QueryTokenSuppliesResponse{
+		Supplies: k.CheckTokenSupplies(ctx),
+	}
+	for _, s := range res.Supplies {
+		res.Broken = res.Broken || s.Broken
+	}
+	return res, nil
+}
+
 func (k Keeper) BlockList(goCtx context.Context, req *types.QueryBlockListRequest) (*types.QueryBlockListResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	blob := ctx.KVStore(k.storeKey).Get(types.KeyPrefixBlockList)
@@ -150,3 +187,96 @@ func (k Keeper) BlockList(goCtx context.Context, req *types.QueryBlockListReques
 		Blob: blob,
 	}, nil
 }
+
+// BridgeStatus returns if the bridge flow is enabled, and all the bridge flows turned off
+func (k Keeper) BridgeStatus(goCtx context.Context, req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateBridgeSwitch(req.Direction, req.Denom, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryBridgeStatusResponse{
+		Enabled:  k.CheckBridgeEnabled(ctx, req.Direction, req.Denom, req.ChannelId) == nil,
+		Disabled: k.GetDisabledBridges(ctx),
+	}, nil
+}
+
+// RateLimits returns the rate limits of ibc transfers and the usages in current windows
+func (k Keeper) RateLimits(goCtx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var res []types.RateLimitStatus
+	for _, limit := range k.GetRateLimits(ctx) {
+		if (req.Denom != "" && req.Denom != limit.Denom) || (req.ChannelId != "" && req.ChannelId != limit.ChannelId) {
+			continue
+		}
+		usage, err := k.GetRateLimitUsage(ctx, limit)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res = append(res, types.RateLimitStatus{
+			RateLimit: limit,
+			Usage:     usage,
+		})
+	}
+	return &types.QueryRateLimitsResponse{RateLimits: res}, nil
+}
+
+// TokenMappings returns all the token mappings with the token metadata, ordered by contract address
+func (k Keeper) TokenMappings(goCtx context.Context, req *types.QueryTokenMappingsRequest) (*types.QueryTokenMappingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractToDenom)
+	var mappings []types.TokenMappingInfo
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		mappings = append(mappings, k.GetTokenMappingInfo(ctx, string(value), common.BytesToAddress(key)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryTokenMappingsResponse{
+		TokenMappings: mappings,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// LogHandlers returns the log handlers registered through governance
+func (k Keeper) LogHandlers(goCtx context.Context, req *types.QueryLogHandlersRequest) (*types.QueryLogHandlersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var contract *common.Address
+	if len(req.Contract) > 0 {
+		if !common.IsHexAddress(req.Contract) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", req.Contract)
+		}
+		addr := common.HexToAddress(req.Contract)
+		contract = &addr
+	}
+	return &types.QueryLogHandlersResponse{LogHandlers: k.GetLogHandlers(ctx, contract)}, nil
+}
+
+// FailedLogs returns the evm logs in the retry queue
+func (k Keeper) FailedLogs(goCtx context.Context, req *types.QueryFailedLogsRequest) (*types.QueryFailedLogsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var txHash *common.Hash
+	if len(req.TxHash) > 0 {
+		if len(common.FromHex(req.TxHash)) != common.HashLength {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %s", req.TxHash)
+		}
+		hash := common.HexToHash(req.TxHash)
+		txHash = &hash
+	}
+	return &types.QueryFailedLogsResponse{FailedLogs: k.GetFailedLogs(ctx, txHash)}, nil
+}
@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,6 +28,10 @@ func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sd
 	params := k.GetParams(ctx)
 	evmParams := k.GetEvmParams(ctx)
 	for _, c := range coins {
+		if err := k.CheckBridgeEnabled(ctx, types.BridgeDirectionInbound, c.Denom, ""); err != nil {
+			return err
+		}
+
 		switch c.Denom {
 		case params.IbcCroDenom:
 			if params.IbcCroDenom == "" {
@@ -77,7 +82,63 @@ func (k Keeper) ConvertVouchersToEvmCoins(ctx sdk.Context, from string, coins sd
 	return nil
 }
 
+// ConvertVouchersBatch sends the ibc voucher coins from the sender to the recipients and converts them to evm coins,
+// it fails if any of the conversions fails, the state should be reverted by the caller.
+func (k Keeper) ConvertVouchersBatch(ctx sdk.Context, sender string, recipients []types.VouchersRecipient) error {
+	acc, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	for i, r := range recipients {
+		if !common.IsHexAddress(r.Address) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address at index %d (%s)", i, r.Address)
+		}
+		recipient := sdk.AccAddress(common.HexToAddress(r.Address).Bytes())
+		if k.bankKeeper.BlockedAddr(recipient) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "recipient at index %d (%s) is not allowed to receive funds", i, r.Address)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, acc, recipient, r.Coins); err != nil {
+			return errorsmod.Wrapf(err, "recipient at index %d", i)
+		}
+		if err := k.ConvertVouchersToEvmCoins(ctx, recipient.String(), r.Coins); err != nil {
+			return errorsmod.Wrapf(err, "recipient at index %d", i)
+		}
+	}
+	return nil
+}
+
 func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coins sdk.Coins, channelId string) error {
+	return k.IbcTransferCoinsWithTimeout(ctx, from, destination, coins, channelId, ibcclienttypes.ZeroHeight(), 0)
+}
+
+// IbcTransferCoinsWithTimeout is similar to `IbcTransferCoins`, but overrides the timeouts of the transfers,
+// if both timeouts are zero, the timeout timestamp is derived from the `IbcTimeout` parameter.
+func (k Keeper) IbcTransferCoinsWithTimeout(
+	ctx sdk.Context,
+	from, destination string,
+	coins sdk.Coins,
+	channelId string,
+	timeoutHeight ibcclienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	return k.ibcTransferCoins(ctx, from, destination, coins, channelId, timeoutHeight, timeoutTimestamp, "")
+}
+
+// IbcTransferCoinsWithMemo is similar to `IbcTransferCoins`, but attaches the memo to the transfers, for example, the
+// forward metadata to route the tokens to another chain by the destination chain.
+func (k Keeper) IbcTransferCoinsWithMemo(ctx sdk.Context, from, destination string, coins sdk.Coins, channelId, memo string) error {
+	return k.ibcTransferCoins(ctx, from, destination, coins, channelId, ibcclienttypes.ZeroHeight(), 0, memo)
+}
+
+func (k Keeper) ibcTransferCoins(
+	ctx sdk.Context,
+	from, destination string,
+	coins sdk.Coins,
+	channelId string,
+	timeoutHeight ibcclienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
 	acc, err := sdk.AccAddressFromBech32(from)
 	if err != nil {
 		return err
@@ -124,7 +185,7 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 			}
 
 			// No need to specify the channelId because it's not a source token
-			err = k.ibcSendTransfer(ctx, acc, destination, ibcCoin, "")
+			err = k.ibcSendTransfer(ctx, acc, destination, ibcCoin, "", timeoutHeight, timeoutTimestamp, memo)
 			if err != nil {
 				return err
 			}
@@ -137,7 +198,7 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 			if !found {
 				return fmt.Errorf("coin %s is not supported", c.Denom)
 			}
-			err = k.ibcSendTransfer(ctx, acc, destination, c, channelId)
+			err = k.ibcSendTransfer(ctx, acc, destination, c, channelId, timeoutHeight, timeoutTimestamp, memo)
 			if err != nil {
 				return err
 			}
@@ -158,7 +219,16 @@ func (k Keeper) IbcTransferCoins(ctx sdk.Context, from, destination string, coin
 	return nil
 }
 
-func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destination string, coin sdk.Coin, channelId string) error {
+func (k Keeper) ibcSendTransfer(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	destination string,
+	coin sdk.Coin,
+	channelId string,
+	timeoutHeight ibcclienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
 	if types.IsSourceCoin(coin.Denom) {
 		if !channeltypes.IsValidChannelID(channelId) {
 			return errors.New("invalid channel id for ibc transfer of source token")
@@ -173,12 +243,37 @@ func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destinat
 		channelId = sourceChannelID
 	}
 
+	_, err := k.SendTransfer(ctx, sender, destination, coin, channelId, timeoutHeight, timeoutTimestamp, memo)
+	return err
+}
+
+// SendTransfer transfers the coin through the channel after the bridge and rate limit checks, returns the sequence
+// of the packet sent, all the outgoing transfers of the module and the precompiled contracts go through it.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	destination string,
+	coin sdk.Coin,
+	channelId string,
+	timeoutHeight ibcclienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	if err := k.CheckBridgeEnabled(ctx, types.BridgeDirectionOutbound, coin.Denom, channelId); err != nil {
+		return 0, err
+	}
+	limited, err := k.CheckAndUpdateRateLimits(ctx, types.BridgeDirectionOutbound, coin.Denom, channelId, coin.Amount)
+	if err != nil {
+		return 0, err
+	}
+
 	// Transfer coins to receiver through IBC
-	// We use current time for timeout timestamp and zero height for timeoutHeight
-	// it means it can never fail by timeout
-	params := k.GetParams(ctx)
-	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + params.IbcTimeout
-	timeoutHeight := ibcclienttypes.ZeroHeight()
+	// If no timeout is specified, we use current time plus the `IbcTimeout` parameter for timeout timestamp
+	// and zero height for timeoutHeight.
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		params := k.GetParams(ctx)
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + params.IbcTimeout
+	}
 	msg := ibctransfertypes.MsgTransfer{
 		SourcePort:       ibctransfertypes.PortID,
 		SourceChannel:    channelId,
@@ -187,9 +282,45 @@ func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, destinat
 		Receiver:         destination,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
-	if _, err := k.transferKeeper.Transfer(ctx, &msg); err != nil {
-		return err
+	res, err := k.transferKeeper.Transfer(ctx, &msg)
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, nil
+	}
+	if limited {
+		k.setRateLimitPendingPacket(ctx, channelId, res.Sequence)
+	}
+	return res.Sequence, nil
+}
+
+// validateTransferChannel checks the explicit channel id matches the source channels of the ibc vouchers to transfer,
+// the channel of the source tokens are validated in `ibcSendTransfer`.
+func (k Keeper) validateTransferChannel(ctx sdk.Context, coins sdk.Coins, channelId string) error {
+	if channelId == "" {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	evmParams := k.GetEvmParams(ctx)
+	for _, c := range coins {
+		denom := c.Denom
+		if denom == evmParams.EvmDenom {
+			denom = params.IbcCroDenom
+		}
+		if !types.IsValidIBCDenom(denom) {
+			continue
+		}
+		sourceChannelID, err := k.GetSourceChannelID(ctx, denom)
+		if err != nil {
+			return err
+		}
+		if sourceChannelID != channelId {
+			return fmt.Errorf("channel id %s doesn't match the source channel %s of coin %s", channelId, sourceChannelID, c.Denom)
+		}
 	}
 	return nil
 }
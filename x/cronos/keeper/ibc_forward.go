@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// ForwardIbcTransfer forwards the tokens received by the intermediate sender to the next hop in the forward metadata,
+// the forwarded packet is recorded together with the received packet, which is acknowledged once the forwarded packet
+// completes, see `OnForwardedPacketRefunded`.
+func (k Keeper) ForwardIbcTransfer(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	coin sdk.Coin,
+	forward types.ForwardMetadata,
+	refundPacket channeltypes.Packet,
+	refundReceiver string,
+) error {
+	if err := forward.Validate(); err != nil {
+		return err
+	}
+	memo, err := forward.GetNextMemo()
+	if err != nil {
+		return err
+	}
+	timeout, err := forward.GetTimeout()
+	if err != nil {
+		return err
+	}
+	var timeoutTimestamp uint64
+	if timeout > 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(timeout).UnixNano())
+	}
+
+	sequence, err := k.SendTransfer(
+		ctx, sender, forward.Receiver, coin, forward.Channel, ibcclienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	)
+	if err != nil {
+		return err
+	}
+	k.SetForwardedPacket(ctx, types.ForwardedPacket{
+		ChannelId:       forward.Channel,
+		Sequence:        sequence,
+		RefundChannelId: refundPacket.GetDestChannel(),
+		RefundReceiver:  refundReceiver,
+		RefundPacket:    refundPacket,
+	})
+
+	ctx.EventManager().EmitEvent(
+		types.NewIbcForwardEvent(sender.String(), forward.Receiver, forward.Channel, sequence, coin),
+	)
+	return nil
+}
+
+// OnForwardedPacketRefunded reverts the receiving of the tokens refunded to the intermediate sender, if the packet is
+// a forwarded one: the tokens unescrowed from the refund channel are escrowed again, the vouchers minted are burned,
+// so the received packet can be acknowledged with an error, and the tokens are refunded to the original sender by
+// the counterparty chain, nothing is left in the intermediate sender. Returns false if it's not a forwarded packet.
+func (k Keeper) OnForwardedPacketRefunded(
+	ctx sdk.Context, channelID string, sequence uint64, sender sdk.AccAddress, coin sdk.Coin,
+) (types.ForwardedPacket, bool, error) {
+	packet, found := k.GetForwardedPacket(ctx, channelID, sequence)
+	if !found {
+		return packet, false, nil
+	}
+	k.DeleteForwardedPacket(ctx, channelID, sequence)
+
+	refund := packet.RefundPacket
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(refund.GetData(), &data); err != nil {
+		return packet, true, err
+	}
+
+	coins := sdk.NewCoins(coin)
+	if transfertypes.ReceiverChainIsSource(refund.GetSourcePort(), refund.GetSourceChannel(), data.Denom) {
+		// the tokens are unescrowed when received
+		escrow := transfertypes.GetEscrowAddress(refund.GetDestPort(), refund.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, sender, escrow, coins); err != nil {
+			return packet, true, err
+		}
+		total := k.transferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, total.Add(coin))
+	} else {
+		// the vouchers are minted when received
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, transfertypes.ModuleName, coins); err != nil {
+			return packet, true, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return packet, true, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewIbcForwardRefundEvent(packet.RefundReceiver, packet.RefundChannelId, coin),
+	)
+	return packet, true, nil
+}
+
+// SetForwardedPacket records an in-flight packet forwarding the received tokens
+func (k Keeper) SetForwardedPacket(ctx sdk.Context, packet types.ForwardedPacket) {
+	ctx.KVStore(k.storeKey).Set(
+		types.ForwardedPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet),
+	)
+}
+
+// GetForwardedPacket returns the in-flight forwarded packet
+func (k Keeper) GetForwardedPacket(ctx sdk.Context, channelID string, sequence uint64) (types.ForwardedPacket, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ForwardedPacketKey(channelID, sequence))
+	if len(bz) == 0 {
+		return types.ForwardedPacket{}, false
+	}
+	var packet types.ForwardedPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeleteForwardedPacket removes the record of the forwarded packet once it's acknowledged or timed out
+func (k Keeper) DeleteForwardedPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.ForwardedPacketKey(channelID, sequence))
+}
+
+// GetForwardedPackets returns all the in-flight forwarded packets
+func (k Keeper) GetForwardedPackets(ctx sdk.Context) (out []types.ForwardedPacket) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixForwardedPacket).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var packet types.ForwardedPacket
+		k.cdc.MustUnmarshal(iter.Value(), &packet)
+		out = append(out, packet)
+	}
+	return
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+func (suite *KeeperTestSuite) TestForwardIbcTransfer() {
+	suite.SetupTest()
+	// Create Cronos Keeper with mock transfer keeper
+	keeper := *cronosmodulekeeper.NewKeeper(
+		suite.app.EncodingConfig().Codec,
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetKey(types.MemStoreKey),
+		suite.app.BankKeeper,
+		keepertest.IbcKeeperMock{},
+		suite.app.EvmKeeper,
+		suite.app.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	sender := types.DeriveForwardSender("channel-0", "crypto1sender")
+	coin := sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+	forward := types.ForwardMetadata{
+		Receiver: "cosmos1receiver",
+		Channel:  "channel-1",
+		Timeout:  "10m",
+		Next:     json.RawMessage(`{"forward": {"receiver": "osmo1receiver", "channel": "channel-2"}}`),
+	}
+	// the vouchers of the token native to the counterparty chain are minted when received
+	data := transfertypes.NewFungibleTokenPacketData("correctIBCToken", "100", "crypto1sender", sender.String(), "")
+	refundPacket := channeltypes.NewPacket(
+		data.GetBytes(), 1, transfertypes.PortID, "channel-9", transfertypes.PortID, "channel-0", ibcclienttypes.NewHeight(0, 100), 0,
+	)
+
+	// invalid metadata
+	suite.Require().Error(keeper.ForwardIbcTransfer(suite.ctx, sender, coin, types.ForwardMetadata{Receiver: "cosmos1receiver"}, refundPacket, "crypto1sender"))
+
+	// the bridge is checked for the next hop
+	keeper.SetBridgeEnabled(suite.ctx, types.BridgeDirectionOutbound, "", "channel-1", false)
+	suite.Require().Error(keeper.ForwardIbcTransfer(suite.ctx, sender, coin, forward, refundPacket, "crypto1sender"))
+	keeper.SetBridgeEnabled(suite.ctx, types.BridgeDirectionOutbound, "", "channel-1", true)
+
+	// the vouchers can be forwarded through channels other than the source one
+	suite.Require().NoError(keeper.ForwardIbcTransfer(suite.ctx, sender, coin, forward, refundPacket, "crypto1sender"))
+	packet := types.ForwardedPacket{
+		ChannelId:       "channel-1",
+		Sequence:        0,
+		RefundChannelId: "channel-0",
+		RefundReceiver:  "crypto1sender",
+		RefundPacket:    refundPacket,
+	}
+	suite.Require().NoError(packet.Validate())
+	suite.Require().Equal([]types.ForwardedPacket{packet}, keeper.GetForwardedPackets(suite.ctx))
+
+	// not a forwarded packet
+	_, found, err := keeper.OnForwardedPacketRefunded(suite.ctx, "channel-1", 1, sender, coin)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// the refunded vouchers are burned, so the counterparty chain refunds the original sender
+	suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(coin)))
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, coin.Denom)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	refunded, found, err := keeper.OnForwardedPacketRefunded(suite.ctx, "channel-1", 0, sender, coin)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(packet, refunded)
+	suite.Require().Empty(keeper.GetForwardedPackets(suite.ctx))
+	suite.Require().True(suite.GetBalance(sender, coin.Denom).IsZero())
+	suite.Require().Equal(supply.Sub(coin).String(), suite.app.BankKeeper.GetSupply(suite.ctx, coin.Denom).String())
+	event := suite.ctx.EventManager().Events()[len(suite.ctx.EventManager().Events())-1]
+	suite.Require().Equal(types.EventTypeIbcForwardRefund, event.Type)
+
+	// the tokens unescrowed when received are escrowed again
+	native := sdk.NewCoin("basetcro", sdkmath.NewInt(100))
+	data = transfertypes.NewFungibleTokenPacketData("transfer/channel-9/basetcro", "100", "crypto1sender", sender.String(), "")
+	refundPacket = channeltypes.NewPacket(
+		data.GetBytes(), 2, transfertypes.PortID, "channel-9", transfertypes.PortID, "channel-0", ibcclienttypes.NewHeight(0, 100), 0,
+	)
+	suite.Require().NoError(keeper.ForwardIbcTransfer(suite.ctx, sender, native, forward, refundPacket, "crypto1sender"))
+	suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(native)))
+	_, found, err = keeper.OnForwardedPacketRefunded(suite.ctx, "channel-1", 0, sender, native)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().True(suite.GetBalance(sender, native.Denom).IsZero())
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	suite.Require().Equal(native.String(), suite.GetBalance(escrow, native.Denom).String())
+}
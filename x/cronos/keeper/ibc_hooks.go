@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// OnRecvEvmHook converts the vouchers received by the intermediate sender of the evm hook to evm coins, and calls the
+// contract with them in the name of the intermediate sender, the gas is capped by the `MaxCallbackGas` parameter.
+// The contract is approved to spend the received CRC21 tokens, or the gas token is sent along with the call.
+// Nobody owns the intermediate sender to recover the leftovers, so the hook fails if the contract don't consume all
+// the received tokens, and the allowance is reset to zero after the call.
+func (k Keeper) OnRecvEvmHook(ctx sdk.Context, sender common.Address, coin sdk.Coin, hook types.EvmHook) error {
+	balance, err := k.evmHookBalance(ctx, sender, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ConvertVouchersToEvmCoins(ctx, sdk.AccAddress(sender.Bytes()).String(), sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	value := big.NewInt(0)
+	var token common.Address
+	if coin.Denom == params.IbcCroDenom {
+		value = new(big.Int).Mul(coin.Amount.BigInt(), types.TenPowTen)
+	} else {
+		var found bool
+		token, found = k.GetContractByDenom(ctx, coin.Denom)
+		if !found {
+			return fmt.Errorf("no contract found for the denom %s", coin.Denom)
+		}
+		if err := k.evmHookApprove(ctx, sender, token, hook.Contract, coin.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	_, res, err := k.CallEVMFrom(ctx, sender, &hook.Contract, hook.Data, value, params.MaxCallbackGas)
+	if err != nil {
+		return err
+	}
+	if res.Failed() {
+		return fmt.Errorf("call contract %s failed: %s", hook.Contract.Hex(), res.VmError)
+	}
+
+	if coin.Denom != params.IbcCroDenom {
+		if err := k.evmHookApprove(ctx, sender, token, hook.Contract, big.NewInt(0)); err != nil {
+			return err
+		}
+	}
+
+	remaining, err := k.evmHookBalance(ctx, sender, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if remaining.Cmp(balance) > 0 {
+		return fmt.Errorf("contract %s didn't consume all the received tokens, %s left", hook.Contract.Hex(), new(big.Int).Sub(remaining, balance))
+	}
+
+	ctx.EventManager().EmitEvent(types.NewEvmHookEvent(sender.Hex(), hook.Contract.Hex(), coin))
+	return nil
+}
+
+// evmHookApprove approves the contract to spend the amount of CRC21 tokens of the intermediate sender.
+func (k Keeper) evmHookApprove(ctx sdk.Context, sender, token, contract common.Address, amount *big.Int) error {
+	data, err := types.ModuleCRC21Contract.ABI.Pack("approve", contract, amount)
+	if err != nil {
+		return err
+	}
+	_, res, err := k.CallEVMFrom(ctx, sender, &token, data, big.NewInt(0), DefaultGasCap)
+	if err != nil {
+		return err
+	}
+	if res.Failed() {
+		return fmt.Errorf("approve contract %s failed: %s", contract.Hex(), res.VmError)
+	}
+	return nil
+}
+
+// evmHookBalance returns the evm balance of the intermediate sender converted from the denom, either the gas token or
+// the CRC21 token, the contract of the later might not be deployed yet.
+func (k Keeper) evmHookBalance(ctx sdk.Context, sender common.Address, denom string) (*big.Int, error) {
+	if denom == k.GetParams(ctx).IbcCroDenom {
+		evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+		return k.bankKeeper.GetBalance(ctx, sdk.AccAddress(sender.Bytes()), evmDenom).Amount.BigInt(), nil
+	}
+	token, found := k.GetContractByDenom(ctx, denom)
+	if !found {
+		return big.NewInt(0), nil
+	}
+	ret, err := k.CallModuleCRC21(ctx, token, "balanceOf", sender)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(ret), nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvEvmHook() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	sender := types.DeriveEvmHookSender("channel-0", "crypto1sender")
+	recipient := common.BigToAddress(big.NewInt(1))
+
+	// the gas token is sent along with the call
+	coin := sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(10))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(sender.Bytes()), sdk.NewCoins(coin)))
+	suite.Require().NoError(keeper.OnRecvEvmHook(suite.ctx, sender, coin, types.EvmHook{Contract: recipient}))
+	suite.Require().Equal(sdkmath.NewInt(100000000000), suite.GetBalance(sdk.AccAddress(recipient.Bytes()), suite.evmParam.EvmDenom).Amount)
+	suite.Require().True(suite.GetBalance(sdk.AccAddress(sender.Bytes()), suite.evmParam.EvmDenom).IsZero())
+
+	// the contract must consume all the crc21 tokens received, nothing is left to the intermediate sender.
+	coin = sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100))
+	ctx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(sender.Bytes()), sdk.NewCoins(coin)))
+	suite.Require().ErrorContains(keeper.OnRecvEvmHook(ctx, sender, coin, types.EvmHook{Contract: recipient}), "didn't consume all the received tokens")
+
+	// the crc21 tokens are approved to the contract, call the token contract itself to forward them.
+	// deploy the token contract by converting the vouchers of another account.
+	other := sdk.AccAddress(common.BigToAddress(big.NewInt(2)).Bytes())
+	suite.Require().NoError(suite.MintCoins(other, sdk.NewCoins(coin)))
+	suite.Require().NoError(keeper.ConvertVouchersToEvmCoins(suite.ctx, other.String(), sdk.NewCoins(coin)))
+	token, found := keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().True(found)
+	data, err := types.ModuleCRC21Contract.ABI.Pack("transferFrom", sender, recipient, coin.Amount.BigInt())
+	suite.Require().NoError(err)
+	hook := types.EvmHook{Contract: token, Data: data}
+
+	// out of gas
+	params := keeper.GetParams(suite.ctx)
+	params.MaxCallbackGas = 30000
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+	ctx, _ = suite.ctx.CacheContext()
+	suite.Require().ErrorContains(keeper.OnRecvEvmHook(ctx, sender, coin, hook), "out of gas")
+
+	params.MaxCallbackGas = 200000
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	// forwarding only part of the tokens fails
+	ctx, _ = suite.ctx.CacheContext()
+	data, err = types.ModuleCRC21Contract.ABI.Pack("transferFrom", sender, recipient, coin.Amount.SubRaw(1).BigInt())
+	suite.Require().NoError(err)
+	suite.Require().ErrorContains(keeper.OnRecvEvmHook(ctx, sender, coin, types.EvmHook{Contract: token, Data: data}), "1 left")
+
+	suite.Require().NoError(keeper.OnRecvEvmHook(suite.ctx, sender, coin, hook))
+
+	ret, err := keeper.CallModuleCRC21(suite.ctx, token, "balanceOf", recipient)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coin.Amount.BigInt(), new(big.Int).SetBytes(ret))
+	ret, err = keeper.CallModuleCRC21(suite.ctx, token, "balanceOf", sender)
+	suite.Require().NoError(err)
+	suite.Require().Zero(new(big.Int).SetBytes(ret).Sign())
+	ret, err = keeper.CallModuleCRC21(suite.ctx, token, "allowance", sender, token)
+	suite.Require().NoError(err)
+	suite.Require().Zero(new(big.Int).SetBytes(ret).Sign())
+	suite.Require().True(suite.GetBalance(sdk.AccAddress(sender.Bytes()), CorrectIbcDenom).IsZero())
+}
@@ -4,14 +4,21 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/transfer"
 	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
 	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 )
@@ -138,6 +145,71 @@ func (suite *KeeperTestSuite) TestConvertVouchersToEvmCoins() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestConvertVouchersBatch() {
+	suite.SetupTest()
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	sender := sdk.AccAddress(privKey.PubKey().Address())
+	recipient1 := common.BigToAddress(big.NewInt(1))
+	recipient2 := common.BigToAddress(big.NewInt(2))
+
+	suite.Require().NoError(suite.MintCoins(sender, sdk.NewCoins(
+		sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(100)),
+		sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(100)),
+	)))
+
+	// the whole batch fails if any recipient fails
+	ctx, _ := suite.ctx.CacheContext()
+	_, err = msgServer.ConvertVouchersBatch(ctx, types.NewMsgConvertVouchersBatch(sender.String(), []types.VouchersRecipient{
+		{Address: recipient1.Hex(), Coins: sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(60)))},
+		{Address: recipient2.Hex(), Coins: sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(60)))},
+	}))
+	suite.Require().ErrorContains(err, "recipient at index 1")
+
+	// the module accounts are not allowed to receive the vouchers
+	ctx, _ = suite.ctx.CacheContext()
+	blocked := common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+	err = suite.app.CronosKeeper.ConvertVouchersBatch(ctx, sender.String(), []types.VouchersRecipient{
+		{Address: recipient1.Hex(), Coins: sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(10)))},
+		{Address: blocked.Hex(), Coins: sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(10)))},
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorContains(err, "recipient at index 1")
+
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ConvertVouchersBatch(ctx, types.NewMsgConvertVouchersBatch(sender.String(), []types.VouchersRecipient{
+		{Address: recipient1.Hex(), Coins: sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(60)))},
+		{Address: recipient2.Hex(), Coins: sdk.NewCoins(
+			sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(40)),
+			sdk.NewCoin(types.IbcCroDenomDefaultValue, sdkmath.NewInt(100)),
+		)},
+	}))
+	suite.Require().NoError(err)
+
+	contract, found := suite.app.CronosKeeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().True(found)
+	for _, expected := range []struct {
+		address common.Address
+		amount  int64
+	}{{recipient1, 60}, {recipient2, 40}} {
+		ret, err := suite.app.CronosKeeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", expected.address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(big.NewInt(expected.amount), big.NewInt(0).SetBytes(ret))
+	}
+	suite.Require().Equal(sdkmath.NewInt(1000000000000), suite.GetBalance(sdk.AccAddress(recipient2.Bytes()), suite.evmParam.EvmDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, sender).IsZero())
+
+	var converted int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeConvertVouchers {
+			converted++
+		}
+	}
+	suite.Require().Equal(2, converted)
+}
+
 func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 	privKey, err := ethsecp256k1.GenerateKey()
 	suite.Require().NoError(err)
@@ -315,3 +387,109 @@ func (suite *KeeperTestSuite) TestIbcTransferCoins() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTransferTokensWithChannel() {
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+
+	testCases := []struct {
+		name          string
+		coin          sdk.Coin
+		channelId     string
+		expectedError error
+	}{
+		{
+			"ibc voucher with default channel",
+			sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(123)),
+			"",
+			nil,
+		},
+		{
+			"ibc voucher with matching channel",
+			sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(123)),
+			"channel-0",
+			nil,
+		},
+		{
+			"ibc voucher with mismatched channel",
+			sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(123)),
+			"channel-1",
+			fmt.Errorf("channel id channel-1 doesn't match the source channel channel-0 of coin %s", CorrectIbcDenom),
+		},
+		{
+			"source token with channel",
+			sdk.NewCoin(CorrectCronosDenom, sdkmath.NewInt(123)),
+			"channel-1",
+			nil,
+		},
+		{
+			"source token without channel",
+			sdk.NewCoin(CorrectCronosDenom, sdkmath.NewInt(123)),
+			"",
+			errors.New("invalid channel id for ibc transfer of source token"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			// Create Cronos Keeper with mock transfer keeper
+			cronosKeeper := *cronosmodulekeeper.NewKeeper(
+				suite.app.EncodingConfig().Codec,
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetKey(types.MemStoreKey),
+				suite.app.BankKeeper,
+				keepertest.IbcKeeperMock{},
+				suite.app.EvmKeeper,
+				suite.app.AccountKeeper,
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			)
+			suite.app.CronosKeeper = cronosKeeper
+
+			suite.MintCoins(address, sdk.NewCoins(tc.coin))
+			suite.app.CronosKeeper.SetAutoContractForDenom(suite.ctx, tc.coin.Denom, common.HexToAddress("0x11"))
+
+			msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+			msg := types.NewMsgTransferTokensWithChannel(
+				address.String(), "to", sdk.NewCoins(tc.coin), tc.channelId, ibcclienttypes.NewHeight(1, 100), 0,
+			)
+			_, err := msgServer.TransferTokens(suite.ctx, msg)
+			if tc.expectedError != nil {
+				suite.Require().EqualError(err, tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferPrecompileChecks() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	sender := common.BigToAddress(big.NewInt(1))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(sender.Bytes()), sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1000)))))
+
+	transferABI, err := abi.JSON(strings.NewReader(transfer.TransferModuleMetaData.ABI))
+	suite.Require().NoError(err)
+	data, err := transferABI.Pack("transfer", "channel-0", "cosmos1receiver", CorrectIbcDenom, big.NewInt(100), big.NewInt(int64(time.Hour)))
+	suite.Require().NoError(err)
+	precompile := common.BytesToAddress([]byte{104})
+	callTransfer := func(ctx sdk.Context) string {
+		_, res, err := keeper.CallEVMFrom(ctx, sender, &precompile, data, big.NewInt(0), 1000000)
+		suite.Require().NoError(err)
+		suite.Require().True(res.Failed())
+		return res.VmError
+	}
+
+	// the bridge is disabled
+	ctx, _ := suite.ctx.CacheContext()
+	keeper.SetBridgeEnabled(ctx, types.BridgeDirectionOutbound, "", "channel-0", false)
+	suite.Require().Contains(callTransfer(ctx), types.ErrBridgeDisabled.Error())
+
+	// the rate limit is exhausted
+	ctx, _ = suite.ctx.CacheContext()
+	keeper.SetRateLimit(ctx, newTestRateLimit(CorrectIbcDenom, "channel-0", 0, 50))
+	suite.Require().Contains(callTransfer(ctx), types.ErrRateLimitExceeded.Error())
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// RegisterInvariants registers all cronos invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "token-supply", TokenSupplyInvariant(k))
+}
+
+// TokenSupplyInvariant checks that the tokens wrapped by the cronos module are fully backed for the ModuleCRC21
+// contracts deployed by the module, see `CheckTokenSupplies`. The external contracts and the failures of calling the
+// contracts are only reported by the `TokenSupplies` query, since they are out of the control of the module, and
+// anyone could halt the chain with them.
+func TokenSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+		for _, s := range k.CheckTokenSupplies(ctx) {
+			if !s.Broken || !s.ModuleOwned || s.Error != "" {
+				continue
+			}
+			count++
+			msg += fmt.Sprintf("\t%s contract %s supply %s backing %s %s\n", s.Denom, s.Contract, s.Supply, s.Backing, s.Error)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "token-supply", fmt.Sprintf(
+			"number of module-owned token contracts not fully backed: %d\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// CheckTokenSupplies checks the supplies of the tokens wrapped by the cronos module against the tokens backing them,
+// for all the contracts mapped to the native denoms, including the ones the denoms are migrated from:
+// - the CRC21 tokens of the vouchers are backed by the native coins escrowed in the contract address.
+// - the native coins of the source tokens are backed by the CRC21 tokens locked by the module in the contract.
+// The contracts are called in a cached context, the state changes are discarded.
+func (k Keeper) CheckTokenSupplies(ctx sdk.Context) []types.TokenSupply {
+	ctx, _ = ctx.CacheContext()
+
+	var mappings []types.TokenMapping
+	mappings = append(mappings, k.GetExternalContracts(ctx)...)
+	mappings = append(mappings, k.GetAutoContracts(ctx)...)
+	mappings = append(mappings, k.GetMigratedContracts(ctx)...)
+
+	seen := make(map[common.Address]bool, len(mappings))
+	supplies := make([]types.TokenSupply, 0, len(mappings))
+	for _, m := range mappings {
+		contract := common.HexToAddress(m.Contract)
+		if seen[contract] {
+			continue
+		}
+		seen[contract] = true
+		supply := k.checkTokenSupply(ctx, m.Denom, contract)
+		autoContract, found := k.getAutoContractByDenom(ctx, m.Denom)
+		supply.ModuleOwned = found && autoContract == contract
+		supplies = append(supplies, supply)
+	}
+	return supplies
+}
+
+func (k Keeper) checkTokenSupply(ctx sdk.Context, denom string, contract common.Address) types.TokenSupply {
+	supply := types.TokenSupply{
+		Denom:    denom,
+		Contract: contract.Hex(),
+		Supply:   sdkmath.ZeroInt(),
+		Backing:  sdkmath.ZeroInt(),
+	}
+
+	var (
+		ret []byte
+		err error
+	)
+	if types.IsSourceCoin(denom) {
+		supply.Supply = k.bankKeeper.GetSupply(ctx, denom).Amount
+		ret, err = k.CallModuleCRC21(ctx, contract, "balanceOf", types.EVMModuleAddress)
+	} else {
+		supply.Backing = k.bankKeeper.GetBalance(ctx, sdk.AccAddress(contract.Bytes()), denom).Amount
+		ret, err = k.CallModuleCRC21(ctx, contract, "totalSupply")
+	}
+	if err != nil {
+		supply.Broken = true
+		supply.Error = err.Error()
+		return supply
+	}
+
+	amount := sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(ret))
+	if types.IsSourceCoin(denom) {
+		supply.Backing = amount
+	} else {
+		supply.Supply = amount
+	}
+	supply.Broken = supply.Supply.GT(supply.Backing)
+	return supply
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestTokenSupplyInvariant() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	invariant := cronosmodulekeeper.TokenSupplyInvariant(keeper)
+	holder := common.BigToAddress(big.NewInt(1))
+
+	amount := sdkmath.NewInt(100)
+	coins := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, amount))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(holder.Bytes()), coins))
+	suite.Require().NoError(keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, holder, coins, true))
+	contract, found := keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().True(found)
+
+	_, broken := invariant(suite.ctx)
+	suite.Require().False(broken)
+	res, err := keeper.TokenSupplies(suite.ctx, &types.QueryTokenSuppliesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Broken)
+	suite.Require().Equal([]types.TokenSupply{{
+		Denom:       CorrectIbcDenom,
+		Contract:    contract.Hex(),
+		Supply:      amount,
+		Backing:     amount,
+		ModuleOwned: true,
+	}}, res.Supplies)
+
+	// the external contracts are only reported by the query, the module nonce is not bumped by internal calls.
+	ctx, _ := suite.ctx.CacheContext()
+	account := suite.app.EvmKeeper.GetAccountOrEmpty(ctx, types.EVMModuleAddress)
+	account.Nonce++
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(ctx, types.EVMModuleAddress, account))
+	externalDenom := "ibc/BBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB"
+	external, err := keeper.DeployModuleCRC21(ctx, externalDenom)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.SetExternalContractForDenom(ctx, externalDenom, external))
+	_, err = keeper.CallModuleCRC21(ctx, external, "mint_by_cronos_module", holder, big.NewInt(1))
+	suite.Require().NoError(err)
+	_, broken = invariant(ctx)
+	suite.Require().False(broken)
+	res, err = keeper.TokenSupplies(ctx, &types.QueryTokenSuppliesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Broken)
+	suite.Require().Equal(types.TokenSupply{
+		Denom:    externalDenom,
+		Contract: external.Hex(),
+		Supply:   sdkmath.NewInt(1),
+		Backing:  sdkmath.ZeroInt(),
+		Broken:   true,
+	}, res.Supplies[0])
+
+	// the extra native coins sent to the contract address don't break it
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(contract.Bytes()), coins))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// the tokens minted without backing
+	_, err = keeper.CallModuleCRC21(suite.ctx, contract, "mint_by_cronos_module", holder, big.NewInt(101))
+	suite.Require().NoError(err)
+	msg, broken := invariant(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, contract.Hex())
+	res, err = keeper.TokenSupplies(suite.ctx, &types.QueryTokenSuppliesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Broken)
+	suite.Require().Equal(sdkmath.NewInt(201), res.Supplies[0].Supply)
+	suite.Require().Equal(sdkmath.NewInt(200), res.Supplies[0].Backing)
+}
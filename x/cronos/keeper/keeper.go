@@ -22,6 +22,7 @@ import (
 	cronosprecompiles "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/precompiles"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	// this line is used by starport scaffolding # ibc/keeper/import
 )
 
@@ -39,6 +40,8 @@ type (
 		evmKeeper types.EvmKeeper
 		// account keeper
 		accountKeeper types.AccountKeeper
+		// process the evm logs in the retry queue
+		logProcessor types.EvmLogProcessor
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -102,6 +105,13 @@ func (k Keeper) getAutoContractByDenom(ctx sdk.Context, denom string) (common.Ad
 	return common.BytesToAddress(bz), true
 }
 
+// SetLogProcessor sets the processor to retry the failed evm logs, must be called before the keeper is copied to
+// the module.
+func (k *Keeper) SetLogProcessor(logProcessor types.EvmLogProcessor) *Keeper {
+	k.logProcessor = logProcessor
+	return k
+}
+
 // GetAuthority returns the x/cronos module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -173,6 +183,28 @@ func (k Keeper) GetAutoContracts(ctx sdk.Context) (out []types.TokenMapping) {
 	return
 }
 
+// GetTokenMappingInfo returns the token mapping joined with the bank metadata of the denom, the decimals is 0 if the
+// metadata is not set.
+func (k Keeper) GetTokenMappingInfo(ctx sdk.Context, denom string, contract common.Address) types.TokenMappingInfo {
+	autoContract, found := k.getAutoContractByDenom(ctx, denom)
+	activeContract, activeFound := k.GetContractByDenom(ctx, denom)
+	info := types.TokenMappingInfo{
+		Denom:        denom,
+		Contract:     contract.Hex(),
+		AutoDeployed: found && autoContract == contract,
+		Active:       activeFound && activeContract == contract,
+	}
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		info.Symbol = metadata.Symbol
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				info.Decimals = unit.Exponent
+			}
+		}
+	}
+	return info
+}
+
 // DeleteExternalContractForDenom delete the external contract mapping for native denom,
 // returns false if mapping not exists.
 func (k Keeper) DeleteExternalContractForDenom(ctx sdk.Context, denom string) bool {
@@ -282,11 +314,13 @@ func (k Keeper) onPacketResult(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement bool,
+	result []byte,
 	relayer sdk.AccAddress,
 	contractAddress,
 	packetSenderAddress string,
 ) error {
-	sender, err := sdk.AccAddressFromBech32(packetSenderAddress)
+	// the packet sender of the interchain accounts is the owner, which could be suffixed with an owner id
+	sender, err := sdk.AccAddressFromBech32(cronosprecompiles.IcaOwnerAccount(packetSenderAddress))
 	if err != nil {
 		return fmt.Errorf("invalid bech32 address: %s, err: %w", packetSenderAddress, err)
 	}
@@ -295,7 +329,14 @@ func (k Keeper) onPacketResult(
 	if senderAddr != contractAddr {
 		return fmt.Errorf("sender is not authenticated: expected %s, got %s", senderAddr, contractAddr)
 	}
-	data, err := cronosprecompiles.OnPacketResultCallback(packet.SourceChannel, packet.Sequence, acknowledgement)
+	// the result bytes are only delivered to the contracts opting in IICACallbackV2, the selector of
+	// `onPacketResultCallback` is kept for the deployed contracts.
+	var data []byte
+	if k.supportsICACallbackV2(ctx, contractAddr) {
+		data, err = cronosprecompiles.OnPacketResultCallbackV2(packet.SourceChannel, packet.Sequence, acknowledgement, result)
+	} else {
+		data, err = cronosprecompiles.OnPacketResultCallback(packet.SourceChannel, packet.Sequence, acknowledgement)
+	}
 	if err != nil {
 		return err
 	}
@@ -304,6 +345,34 @@ func (k Keeper) onPacketResult(
 	return err
 }
 
+// supportsICACallbackV2 queries the ERC-165 `supportsInterface` of the contract without committing the state, the
+// intrinsic gas is charged on top of the gas limit of the standard, any failure is treated as not supported.
+func (k Keeper) supportsICACallbackV2(ctx sdk.Context, contract common.Address) bool {
+	data, err := cronosprecompiles.SupportsICACallbackV2()
+	if err != nil {
+		return false
+	}
+	intrinsicGas, err := core.IntrinsicGas(data, nil, false, true, true, true)
+	if err != nil {
+		return false
+	}
+	msg := &core.Message{
+		From:     types.EVMModuleAddress,
+		To:       &contract,
+		Nonce:    k.evmKeeper.GetNonce(ctx, types.EVMModuleAddress),
+		Value:    big.NewInt(0),
+		GasLimit: intrinsicGas + ERC165GasLimit,
+		GasPrice: big.NewInt(0),
+		Data:     data,
+	}
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, nil, false)
+	if err != nil || res.Failed() {
+		return false
+	}
+	supported, err := cronosprecompiles.UnpackSupportsInterface(res.Ret)
+	return err == nil && supported
+}
+
 func (k Keeper) IBCOnAcknowledgementPacketCallback(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -312,19 +381,19 @@ func (k Keeper) IBCOnAcknowledgementPacketCallback(
 	contractAddress,
 	packetSenderAddress string,
 ) error {
-	// the ack is wrapped by fee middleware
+	// the ack is wrapped by fee middleware if the fee is enabled on the channel
 	var ack ibcfeetypes.IncentivizedAcknowledgement
-	if err := k.cdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
-		return err
-	}
-	if !ack.Success() {
-		return k.onPacketResult(ctx, packet, false, relayer, contractAddress, packetSenderAddress)
+	if err := k.cdc.UnmarshalJSON(acknowledgement, &ack); err == nil {
+		if !ack.Success() {
+			return k.onPacketResult(ctx, packet, false, nil, relayer, contractAddress, packetSenderAddress)
+		}
+		acknowledgement = ack.AppAcknowledgement
 	}
 	var res channeltypes.Acknowledgement
-	if err := k.cdc.UnmarshalJSON(ack.AppAcknowledgement, &res); err != nil {
+	if err := k.cdc.UnmarshalJSON(acknowledgement, &res); err != nil {
 		return err
 	}
-	return k.onPacketResult(ctx, packet, res.Success(), relayer, contractAddress, packetSenderAddress)
+	return k.onPacketResult(ctx, packet, res.Success(), res.GetResult(), relayer, contractAddress, packetSenderAddress)
 }
 
 func (k Keeper) IBCOnTimeoutPacketCallback(
@@ -334,7 +403,7 @@ func (k Keeper) IBCOnTimeoutPacketCallback(
 	contractAddress,
 	packetSenderAddress string,
 ) error {
-	return k.onPacketResult(ctx, packet, false, relayer, contractAddress, packetSenderAddress)
+	return k.onPacketResult(ctx, packet, false, nil, relayer, contractAddress, packetSenderAddress)
 }
 
 func (k Keeper) IBCReceivePacketCallback(
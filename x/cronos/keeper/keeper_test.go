@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
 	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
+	cronosprecompiles "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/precompiles"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -17,10 +19,13 @@ import (
 	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	"github.com/cometbft/cometbft/version"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
@@ -201,6 +206,38 @@ func (suite *KeeperTestSuite) TestDenomContractMap() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTokenMappings() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	autoContract := common.BigToAddress(big.NewInt(1))
+	externalContract := common.BigToAddress(big.NewInt(2))
+	sourceContract := common.BigToAddress(big.NewInt(3))
+
+	keeper.SetAutoContractForDenom(suite.ctx, denom, autoContract)
+	suite.Require().NoError(keeper.SetExternalContractForDenom(suite.ctx, denom, externalContract))
+	suite.Require().NoError(suite.RegisterSourceToken(sourceContract.Hex(), "TEST", 6))
+
+	rsp, err := keeper.TokenMappings(suite.ctx, &types.QueryTokenMappingsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenMappingInfo{
+		{Denom: denom, Contract: autoContract.Hex(), AutoDeployed: true},
+		{Denom: denom, Contract: externalContract.Hex(), Active: true},
+	}, rsp.TokenMappings)
+	suite.Require().NotEmpty(rsp.Pagination.NextKey)
+
+	rsp, err = keeper.TokenMappings(suite.ctx, &types.QueryTokenMappingsRequest{
+		Pagination: &query.PageRequest{Key: rsp.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.TokenMappingInfo{
+		{Denom: "cronos" + sourceContract.Hex(), Contract: sourceContract.Hex(), Symbol: "TEST", Decimals: 6, Active: true},
+	}, rsp.TokenMappings)
+	suite.Require().Empty(rsp.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) MintCoinsToModule(module string, coins sdk.Coins) error {
 	err := suite.app.BankKeeper.MintCoins(suite.ctx, module, coins)
 	if err != nil {
@@ -425,3 +462,93 @@ func (suite *KeeperTestSuite) TestRegisterOrUpdateTokenMapping() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestIBCOnAcknowledgementPacketCallback() {
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: "transfer", SourceChannel: "channel-0"}
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	errorAck := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+	feeAck := func(ack []byte, success bool) []byte {
+		return ibcfeetypes.NewIncentivizedAcknowledgement("relayer", ack, success).Acknowledgement()
+	}
+
+	testCases := []struct {
+		name        string
+		ack         []byte
+		otherSender bool
+		ownerID     string
+		expErr      bool
+	}{
+		{"plain success ack", successAck, false, "", false},
+		{"plain error ack", errorAck, false, "", false},
+		{"fee wrapped success ack", feeAck(successAck, true), false, "", false},
+		{"fee wrapped underlying failure", feeAck(errorAck, false), false, "", false},
+		{"invalid ack", []byte("invalid"), false, "", true},
+		{"sender is not the contract", successAck, true, "", true},
+		{"interchain account owner with owner id", successAck, false, "1", false},
+		{"interchain account owner of other sender", successAck, true, "1", true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender := suite.address
+			if tc.otherSender {
+				sender = common.BigToAddress(big.NewInt(1))
+			}
+			packetSender := sdk.AccAddress(sender.Bytes()).String()
+			if len(tc.ownerID) > 0 {
+				packetSender += cronosprecompiles.IcaOwnerSeparator + tc.ownerID
+			}
+			err := suite.app.CronosKeeper.IBCOnAcknowledgementPacketCallback(
+				suite.ctx, packet, tc.ack, nil,
+				suite.address.Hex(), packetSender,
+			)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIBCOnAcknowledgementPacketCallbackV2() {
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: "transfer", SourceChannel: "channel-0"}
+	ack := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+
+	testCases := []struct {
+		name     string
+		code     []byte
+		selector []byte
+	}{
+		// stores the first word of the calldata and returns false to any call
+		{"contract doesn't support v2", common.FromHex("0x60003560005560206000f3"), []byte{}},
+		// stores the first word of the calldata and returns true to any call
+		{"contract supports v2", common.FromHex("0x600035600055600160005260206000f3"), cronosprecompiles.ICACallbackV2InterfaceID[:]},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract := common.BigToAddress(big.NewInt(1000))
+			codeHash := ethcrypto.Keccak256(tc.code)
+			suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, tc.code)
+			account := suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, contract)
+			account.CodeHash = codeHash
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, contract, account))
+
+			err := suite.app.CronosKeeper.IBCOnAcknowledgementPacketCallback(
+				suite.ctx, packet, ack, nil,
+				contract.Hex(), sdk.AccAddress(contract.Bytes()).String(),
+			)
+			suite.Require().NoError(err)
+
+			selector := tc.selector
+			if len(selector) == 0 {
+				data, err := cronosprecompiles.OnPacketResultCallback(packet.SourceChannel, packet.Sequence, true)
+				suite.Require().NoError(err)
+				selector = data[:4]
+			}
+			stored := suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{})
+			suite.Require().Equal(selector, stored.Bytes()[:4])
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// SetLogHandler registers or replaces the log handler of the event emitted by the contract.
+func (k Keeper) SetLogHandler(ctx sdk.Context, handler types.LogHandler) error {
+	event, err := handler.ParseEvent()
+	if err != nil {
+		return err
+	}
+	key := types.LogHandlerKey(common.HexToAddress(handler.Contract), event.ID)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&handler))
+	return nil
+}
+
+// GetLogHandler returns the log handler of the event emitted by the contract.
+func (k Keeper) GetLogHandler(ctx sdk.Context, contract common.Address, eventID common.Hash) (types.LogHandler, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LogHandlerKey(contract, eventID))
+	if bz == nil {
+		return types.LogHandler{}, false
+	}
+	var handler types.LogHandler
+	k.cdc.MustUnmarshal(bz, &handler)
+	return handler, true
+}
+
+// RemoveLogHandler removes the log handler of the event emitted by the contract, returns false if not found.
+func (k Keeper) RemoveLogHandler(ctx sdk.Context, contract common.Address, eventID common.Hash) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := types.LogHandlerKey(contract, eventID)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	return true
+}
+
+// GetLogHandlers returns the log handlers of the contract, or all of them if the contract is nil.
+func (k Keeper) GetLogHandlers(ctx sdk.Context, contract *common.Address) (out []types.LogHandler) {
+	prefixKey := types.KeyPrefixLogHandler
+	if contract != nil {
+		prefixKey = append(append([]byte{}, prefixKey...), contract.Bytes()...)
+	}
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var handler types.LogHandler
+		k.cdc.MustUnmarshal(iter.Value(), &handler)
+		out = append(out, handler)
+	}
+	return out
+}
@@ -29,3 +29,9 @@ func (i IbcKeeperMock) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.Hex
 	}
 	return types.DenomTrace{}, false
 }
+
+func (i IbcKeeperMock) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	return sdk.NewInt64Coin(denom, 0)
+}
+
+func (i IbcKeeperMock) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {}
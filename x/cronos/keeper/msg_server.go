@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type msgServer struct {
@@ -42,11 +43,32 @@ func (k msgServer) ConvertVouchers(goCtx context.Context, msg *types.MsgConvertV
 	return &types.MsgConvertVouchersResponse{}, nil
 }
 
+func (k msgServer) ConvertVouchersBatch(goCtx context.Context, msg *types.MsgConvertVouchersBatch) (*types.MsgConvertVouchersBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ConvertVouchersBatch(ctx, msg.Sender, msg.Recipients); err != nil {
+		return nil, err
+	}
+
+	// emit events
+	events := make(sdk.Events, 0, len(msg.Recipients)+1)
+	for _, r := range msg.Recipients {
+		events = append(events, types.NewConvertVouchersToEvent(msg.Sender, r.Address, r.Coins))
+	}
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+	))
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgConvertVouchersBatchResponse{}, nil
+}
+
 func (k msgServer) TransferTokens(goCtx context.Context, msg *types.MsgTransferTokens) (*types.MsgTransferTokensResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	// TODO change the msg to be able to specify the channel id
-	// Only sending non source token is supported at the moment
-	err := k.IbcTransferCoins(ctx, msg.From, msg.To, msg.Coins, "")
+	if err := k.validateTransferChannel(ctx, msg.Coins, msg.ChannelId); err != nil {
+		return nil, err
+	}
+	err := k.IbcTransferCoinsWithTimeout(ctx, msg.From, msg.To, msg.Coins, msg.ChannelId, msg.TimeoutHeight, msg.TimeoutTimestamp)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +90,7 @@ func (k msgServer) UpdateTokenMapping(goCtx context.Context, msg *types.MsgUpdat
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check permission
-	if !k.Keeper.HasPermission(ctx, msg.GetSigners(), CanChangeTokenMapping) {
+	if !k.Keeper.IsAuthorized(ctx, msg.GetSigners(), sdk.MsgTypeURL(msg)) {
 		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")
 	}
 
@@ -81,7 +103,19 @@ func (k msgServer) UpdateTokenMapping(goCtx context.Context, msg *types.MsgUpdat
 
 // TurnBridge implements the grpc method
 func (k msgServer) TurnBridge(goCtx context.Context, msg *types.MsgTurnBridge) (*types.MsgTurnBridgeResponse, error) {
-	return nil, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.Keeper.IsAuthorized(ctx, msg.GetSigners(), sdk.MsgTypeURL(msg)) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")
+	}
+
+	k.Keeper.SetBridgeEnabled(ctx, msg.Direction, msg.Denom, msg.ChannelId, msg.Enable)
+
+	ctx.EventManager().EmitEvent(
+		types.NewTurnBridgeEvent(msg.Sender, msg.Enable, msg.Direction, msg.Denom, msg.ChannelId),
+	)
+	return &types.MsgTurnBridgeResponse{}, nil
 }
 
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -99,26 +133,183 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 func (k msgServer) UpdatePermissions(goCtx context.Context, msg *types.MsgUpdatePermissions) (*types.MsgUpdatePermissionsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	admin := k.Keeper.GetParams(ctx).CronosAdmin
-	// if admin is empty, no sender could be equal to it
-	if admin != msg.From {
-		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")
+	if err := k.checkAdmin(ctx, msg.From); err != nil {
+		return nil, err
 	}
 	acc, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
 		return nil, err
 	}
 	k.SetPermissions(ctx, acc, msg.Permissions)
-
 	return &types.MsgUpdatePermissionsResponse{}, nil
 }
 
 func (k msgServer) StoreBlockList(goCtx context.Context, msg *types.MsgStoreBlockList) (*types.MsgStoreBlockListResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	admin := k.Keeper.GetParams(ctx).CronosAdmin
-	if admin != msg.From {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+	if !k.Keeper.IsAuthorized(ctx, []sdk.AccAddress{from}, sdk.MsgTypeURL(msg)) {
 		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")
 	}
 	ctx.KVStore(k.storeKey).Set(types.KeyPrefixBlockList, msg.Blob)
 	return &types.MsgStoreBlockListResponse{}, nil
 }
+
+// SetRateLimit implements the grpc method
+func (k msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetRateLimit(ctx, msg.RateLimit)
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit implements the grpc method
+func (k msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.Keeper.RemoveRateLimit(ctx, msg.Denom, msg.ChannelId) {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "rate limit not found, denom: %s, channel: %s", msg.Denom, msg.ChannelId)
+	}
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// MigrateTokenContract implements the grpc method
+func (k msgServer) MigrateTokenContract(goCtx context.Context, msg *types.MsgMigrateTokenContract) (*types.MsgMigrateTokenContractResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	oldContract, _ := k.GetContractByDenom(ctx, msg.Denom)
+	newContract, err := k.Keeper.MigrateTokenContract(ctx, msg.Denom, msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		types.NewMigrateTokenContractEvent(msg.Denom, oldContract.Hex(), newContract.Hex()),
+	)
+	return &types.MsgMigrateTokenContractResponse{Contract: newContract.Hex()}, nil
+}
+
+// MigrateTokenBalances implements the grpc method
+func (k msgServer) MigrateTokenBalances(goCtx context.Context, msg *types.MsgMigrateTokenBalances) (*types.MsgMigrateTokenBalancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	holders := make([]common.Address, len(msg.Holders))
+	for i, holder := range msg.Holders {
+		holders[i] = common.HexToAddress(holder)
+	}
+	if _, err := k.Keeper.MigrateTokenBalances(ctx, common.HexToAddress(msg.Contract), holders); err != nil {
+		return nil, err
+	}
+	return &types.MsgMigrateTokenBalancesResponse{}, nil
+}
+
+// SetRole implements the grpc method
+func (k msgServer) SetRole(goCtx context.Context, msg *types.MsgSetRole) (*types.MsgSetRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.From); err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetRole(ctx, msg.Role); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRoleResponse{}, nil
+}
+
+// DeleteRole implements the grpc method
+func (k msgServer) DeleteRole(goCtx context.Context, msg *types.MsgDeleteRole) (*types.MsgDeleteRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.From); err != nil {
+		return nil, err
+	}
+	revoked, err := k.Keeper.DeleteRole(ctx, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range revoked {
+		ctx.EventManager().EmitEvent(types.NewRevokeRoleEvent(grant.Address, grant.Role))
+	}
+	return &types.MsgDeleteRoleResponse{}, nil
+}
+
+// GrantRole implements the grpc method
+func (k msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.From); err != nil {
+		return nil, err
+	}
+	if msg.Expiration != nil && !ctx.BlockTime().Before(*msg.Expiration) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "expiration %s is in the past", msg.Expiration)
+	}
+	if err := k.Keeper.GrantRole(ctx, types.RoleGrant{
+		Address:    msg.Address,
+		Role:       msg.Role,
+		Expiration: msg.Expiration,
+	}); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(types.NewGrantRoleEvent(msg.Address, msg.Role, msg.Expiration))
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+// RevokeRole implements the grpc method
+func (k msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.From); err != nil {
+		return nil, err
+	}
+	acc, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !k.Keeper.RevokeRole(ctx, acc, msg.Role) {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "role %s is not granted to %s", msg.Role, msg.Address)
+	}
+	ctx.EventManager().EmitEvent(types.NewRevokeRoleEvent(msg.Address, msg.Role))
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+// checkAdmin returns error if the sender is not the cronos admin,
+// the role and permission management are reserved for the cronos admin.
+func (k msgServer) checkAdmin(ctx sdk.Context, sender string) error {
+	admin := k.Keeper.GetParams(ctx).CronosAdmin
+	// if admin is empty, no sender could be equal to it
+	if admin != sender {
+		return errors.Wrap(sdkerrors.ErrUnauthorized, "msg sender is not authorized")
+	}
+	return nil
+}
+
+// SetLogHandler implements the grpc method
+func (k msgServer) SetLogHandler(goCtx context.Context, msg *types.MsgSetLogHandler) (*types.MsgSetLogHandlerResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetLogHandler(ctx, msg.LogHandler); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetLogHandlerResponse{}, nil
+}
+
+// RemoveLogHandler implements the grpc method
+func (k msgServer) RemoveLogHandler(goCtx context.Context, msg *types.MsgRemoveLogHandler) (*types.MsgRemoveLogHandlerResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.Keeper.RemoveLogHandler(ctx, common.HexToAddress(msg.Contract), common.HexToHash(msg.EventId)) {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "log handler not found, contract: %s, event: %s", msg.Contract, msg.EventId)
+	}
+	return &types.MsgRemoveLogHandlerResponse{}, nil
+}
@@ -1,30 +1,48 @@
 package keeper
 
 import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
 
 // A permission is represented by a bit within uint64 (64bits)
 // An address permission is an integer value between 0 and (2^64-1)
-// This design allows a quick and simple permission check for addresses
+// The permission bits are kept for compatibility, each one is mapped to a built-in role.
 // The next permission should be added before All
 const (
-	CanChangeTokenMapping uint64                                  = 1 << iota // 1
-	CanTurnBridge                                                             // 2
-	All                   = CanChangeTokenMapping | CanTurnBridge             // 3
+	CanChangeTokenMapping = types.PermissionChangeTokenMapping    // 1
+	CanTurnBridge         = types.PermissionTurnBridge            // 2
+	All                   = CanChangeTokenMapping | CanTurnBridge // 3
 )
 
+// SetPermissions grants the built-in roles of the permission bits to the address, and revokes the others,
+// the grants never expire, the same events as the role grant and revocation messages are emitted.
 func (k Keeper) SetPermissions(ctx sdk.Context, address sdk.AccAddress, permissions uint64) {
-	store := ctx.KVStore(k.storeKey)
-	permissionsBytes := sdk.Uint64ToBigEndian(permissions)
-	store.Set(types.AdminToPermissionsKey(address), permissionsBytes)
+	for _, pr := range types.PermissionRoles {
+		if permissions&pr.Permission == pr.Permission {
+			k.setRoleGrant(ctx, address, types.RoleGrant{
+				Address: address.String(),
+				Role:    pr.Role,
+			})
+			ctx.EventManager().EmitEvent(types.NewGrantRoleEvent(address.String(), pr.Role, nil))
+		} else if k.RevokeRole(ctx, address, pr.Role) {
+			ctx.EventManager().EmitEvent(types.NewRevokeRoleEvent(address.String(), pr.Role))
+		}
+	}
 }
 
+// GetPermissions returns the permission bits of the active built-in role grants of the address.
 func (k Keeper) GetPermissions(ctx sdk.Context, address sdk.AccAddress) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	permissionsBytes := store.Get(types.AdminToPermissionsKey(address))
-	return sdk.BigEndianToUint64(permissionsBytes)
+	var permissions uint64
+	for _, pr := range types.PermissionRoles {
+		if grant, found := k.GetRoleGrant(ctx, address, pr.Role); found && grant.IsActive(ctx.BlockTime()) {
+			permissions |= pr.Permission
+		}
+	}
+	return permissions
 }
 
 // HasPermission check if an account has a specific permission. by default cronos admin has all permissions
@@ -33,16 +51,168 @@ func (k Keeper) HasPermission(ctx sdk.Context, accounts []sdk.AccAddress, permis
 	if permissionsToCheck == 0 {
 		return true
 	}
+	var msgTypes []string
+	for _, pr := range types.PermissionRoles {
+		if permissionsToCheck&pr.Permission == pr.Permission {
+			builtin, _ := types.GetBuiltinRole(pr.Role)
+			msgTypes = append(msgTypes, builtin.MsgTypes...)
+		}
+	}
+	for _, account := range accounts {
+		authorized := true
+		for _, msgType := range msgTypes {
+			if !k.IsAuthorized(ctx, []sdk.AccAddress{account}, msgType) {
+				authorized = false
+				break
+			}
+		}
+		if authorized {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsAuthorized check if any of the accounts is allowed to execute the message type,
+// either it's the cronos admin, or it holds an active role which allows the message type.
+func (k Keeper) IsAuthorized(ctx sdk.Context, accounts []sdk.AccAddress, msgType string) bool {
 	admin := k.GetParams(ctx).CronosAdmin
 	for _, account := range accounts {
 		if admin == account.String() {
 			return true
 		}
-		permission := k.GetPermissions(ctx, account)
-		if permission&permissionsToCheck == permissionsToCheck {
-			return true
+		for _, grant := range k.GetRoleGrantsByAddress(ctx, account) {
+			if !grant.IsActive(ctx.BlockTime()) {
+				continue
+			}
+			if role, found := k.GetRole(ctx, grant.Role); found && role.AllowMsgType(msgType) {
+				return true
+			}
 		}
 	}
-
 	return false
 }
+
+// GetRole returns the built-in or custom role by name
+func (k Keeper) GetRole(ctx sdk.Context, name string) (types.Role, bool) {
+	if role, found := types.GetBuiltinRole(name); found {
+		return role, true
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.RoleKey(name))
+	if bz == nil {
+		return types.Role{}, false
+	}
+	var role types.Role
+	k.cdc.MustUnmarshal(bz, &role)
+	return role, true
+}
+
+// SetRole creates or updates a custom role, the built-in roles can't be modified.
+func (k Keeper) SetRole(ctx sdk.Context, role types.Role) error {
+	if _, found := types.GetBuiltinRole(role.Name); found {
+		return fmt.Errorf("can't modify built-in role: %s", role.Name)
+	}
+	ctx.KVStore(k.storeKey).Set(types.RoleKey(role.Name), k.cdc.MustMarshal(&role))
+	return nil
+}
+
+// DeleteRole deletes a custom role and revokes it from all the holders, returns the revoked grants.
+func (k Keeper) DeleteRole(ctx sdk.Context, name string) ([]types.RoleGrant, error) {
+	if _, found := types.GetBuiltinRole(name); found {
+		return nil, fmt.Errorf("can't delete built-in role: %s", name)
+	}
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.RoleKey(name)) {
+		return nil, fmt.Errorf("role not found: %s", name)
+	}
+	store.Delete(types.RoleKey(name))
+
+	var revoked []types.RoleGrant
+	for _, grant := range k.GetAllRoleGrants(ctx) {
+		if grant.Role != name {
+			continue
+		}
+		store.Delete(types.RoleGrantKey(sdk.MustAccAddressFromBech32(grant.Address), name))
+		revoked = append(revoked, grant)
+	}
+	return revoked, nil
+}
+
+// GetRoles returns the built-in roles followed by the custom roles
+func (k Keeper) GetRoles(ctx sdk.Context) []types.Role {
+	out := append([]types.Role{}, types.BuiltinRoles...)
+	return append(out, k.GetCustomRoles(ctx)...)
+}
+
+// GetCustomRoles returns all the custom roles
+func (k Keeper) GetCustomRoles(ctx sdk.Context) (out []types.Role) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRole).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var role types.Role
+		k.cdc.MustUnmarshal(iter.Value(), &role)
+		out = append(out, role)
+	}
+	return out
+}
+
+// GrantRole grants an existing role to the account, the existing grant of the same role is replaced.
+func (k Keeper) GrantRole(ctx sdk.Context, grant types.RoleGrant) error {
+	address, err := sdk.AccAddressFromBech32(grant.Address)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetRole(ctx, grant.Role); !found {
+		return fmt.Errorf("role not found: %s", grant.Role)
+	}
+	k.setRoleGrant(ctx, address, grant)
+	return nil
+}
+
+func (k Keeper) setRoleGrant(ctx sdk.Context, address sdk.AccAddress, grant types.RoleGrant) {
+	ctx.KVStore(k.storeKey).Set(types.RoleGrantKey(address, grant.Role), k.cdc.MustMarshal(&grant))
+}
+
+// RevokeRole revokes the role from the account, returns false if not granted.
+func (k Keeper) RevokeRole(ctx sdk.Context, address sdk.AccAddress, role string) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RoleGrantKey(address, role)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	return true
+}
+
+// GetRoleGrant returns the grant of the role to the account, it could be expired.
+func (k Keeper) GetRoleGrant(ctx sdk.Context, address sdk.AccAddress, role string) (types.RoleGrant, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RoleGrantKey(address, role))
+	if bz == nil {
+		return types.RoleGrant{}, false
+	}
+	var grant types.RoleGrant
+	k.cdc.MustUnmarshal(bz, &grant)
+	return grant, true
+}
+
+// GetRoleGrantsByAddress returns the role grants of the account, including the expired ones.
+func (k Keeper) GetRoleGrantsByAddress(ctx sdk.Context, address sdk.AccAddress) []types.RoleGrant {
+	return k.iterateRoleGrants(prefix.NewStore(ctx.KVStore(k.storeKey), types.RoleGrantsPrefix(address)))
+}
+
+// GetAllRoleGrants returns the role grants of all the accounts, including the expired ones.
+func (k Keeper) GetAllRoleGrants(ctx sdk.Context) []types.RoleGrant {
+	return k.iterateRoleGrants(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRoleGrant))
+}
+
+func (k Keeper) iterateRoleGrants(store prefix.Store) (out []types.RoleGrant) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.RoleGrant
+		k.cdc.MustUnmarshal(iter.Value(), &grant)
+		out = append(out, grant)
+	}
+	return out
+}
@@ -1,8 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	. "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 )
@@ -24,9 +28,21 @@ func (suite *KeeperTestSuite) TestSetAndGetPermissions() {
 	permissions = keeper.GetPermissions(suite.ctx, cosmosAddress)
 	suite.Require().Equal(CanChangeTokenMapping, permissions)
 
-	keeper.SetPermissions(suite.ctx, cosmosAddress, CanTurnBridge)
-	permissions = keeper.GetPermissions(suite.ctx, cosmosAddress)
+	// the revoked role emits the same event as the revoke message, the roles not granted are not revoked.
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	keeper.SetPermissions(ctx, cosmosAddress, CanTurnBridge)
+	permissions = keeper.GetPermissions(ctx, cosmosAddress)
 	suite.Require().Equal(CanTurnBridge, permissions)
+	suite.Require().Equal(sdk.Events{
+		types.NewRevokeRoleEvent(cosmosAddress.String(), types.RoleTokenMappingOperator),
+		types.NewGrantRoleEvent(cosmosAddress.String(), types.RoleBridgeOperator, nil),
+	}, ctx.EventManager().Events())
+
+	ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	keeper.SetPermissions(ctx, cosmosAddress, CanTurnBridge)
+	suite.Require().Equal(sdk.Events{
+		types.NewGrantRoleEvent(cosmosAddress.String(), types.RoleBridgeOperator, nil),
+	}, ctx.EventManager().Events())
 
 	keeper.SetPermissions(suite.ctx, cosmosAddress, All)
 	permissions = keeper.GetPermissions(suite.ctx, cosmosAddress)
@@ -61,3 +77,109 @@ func (suite *KeeperTestSuite) TestHasPermissions() {
 	suite.Require().Equal(true, keeper.HasPermission(suite.ctx, cosmosAddress, CanChangeTokenMapping))
 	suite.Require().Equal(true, keeper.HasPermission(suite.ctx, cosmosAddress, CanTurnBridge))
 }
+
+func (suite *KeeperTestSuite) TestRoles() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	msgServer := NewMsgServerImpl(keeper)
+	admin := sdk.AccAddress(suite.address.Bytes())
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	user := sdk.AccAddress(priv.PubKey().Address())
+
+	// the type urls are consistent with the registered messages
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgUpdateTokenMapping{}), types.TypeURLMsgUpdateTokenMapping)
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgTurnBridge{}), types.TypeURLMsgTurnBridge)
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgStoreBlockList{}), types.TypeURLMsgStoreBlockList)
+
+	role := types.Role{Name: "blocklist_operator", MsgTypes: []string{types.TypeURLMsgStoreBlockList}}
+
+	// only admin can manage the roles
+	_, err = msgServer.SetRole(suite.ctx, types.NewMsgSetRole(user.String(), role))
+	suite.Require().Error(err)
+	_, err = msgServer.SetRole(suite.ctx, types.NewMsgSetRole(admin.String(), types.Role{
+		Name: types.RoleBridgeOperator, MsgTypes: []string{types.TypeURLMsgStoreBlockList},
+	}))
+	suite.Require().Error(err)
+	_, err = msgServer.SetRole(suite.ctx, types.NewMsgSetRole(admin.String(), role))
+	suite.Require().NoError(err)
+
+	_, err = keeper.Roles(suite.ctx, nil)
+	suite.Require().Error(err)
+	rsp, err := keeper.Roles(suite.ctx, &types.QueryRolesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(append(append([]types.Role{}, types.BuiltinRoles...), role), rsp.Roles)
+
+	blockList := types.NewMsgStoreBlockList(user.String(), []byte{})
+	suite.Require().False(keeper.IsAuthorized(suite.ctx, []sdk.AccAddress{user}, sdk.MsgTypeURL(blockList)))
+
+	// grant role which expires in an hour
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	_, err = msgServer.GrantRole(suite.ctx, types.NewMsgGrantRole(user.String(), user.String(), role.Name, &expiration))
+	suite.Require().Error(err)
+	_, err = msgServer.GrantRole(suite.ctx, types.NewMsgGrantRole(admin.String(), user.String(), "unknown", nil))
+	suite.Require().Error(err)
+	_, err = msgServer.GrantRole(suite.ctx, types.NewMsgGrantRole(admin.String(), user.String(), role.Name, &expiration))
+	suite.Require().NoError(err)
+	suite.Require().True(keeper.IsAuthorized(suite.ctx, []sdk.AccAddress{user}, sdk.MsgTypeURL(blockList)))
+	suite.Require().False(keeper.IsAuthorized(suite.ctx, []sdk.AccAddress{user}, types.TypeURLMsgTurnBridge))
+
+	// expired
+	ctx := suite.ctx.WithBlockTime(expiration)
+	suite.Require().False(keeper.IsAuthorized(ctx, []sdk.AccAddress{user}, sdk.MsgTypeURL(blockList)))
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin.String(), user.String(), role.Name, &expiration))
+	suite.Require().Error(err)
+
+	// revoke
+	_, err = msgServer.RevokeRole(suite.ctx, types.NewMsgRevokeRole(admin.String(), user.String(), role.Name))
+	suite.Require().NoError(err)
+	suite.Require().False(keeper.IsAuthorized(suite.ctx, []sdk.AccAddress{user}, sdk.MsgTypeURL(blockList)))
+	_, err = msgServer.RevokeRole(suite.ctx, types.NewMsgRevokeRole(admin.String(), user.String(), role.Name))
+	suite.Require().Error(err)
+
+	// delete role revokes the grants
+	suite.Require().NoError(keeper.GrantRole(suite.ctx, types.RoleGrant{Address: user.String(), Role: role.Name}))
+	_, err = msgServer.DeleteRole(suite.ctx, types.NewMsgDeleteRole(admin.String(), role.Name))
+	suite.Require().NoError(err)
+	suite.Require().Empty(keeper.GetRoleGrantsByAddress(suite.ctx, user))
+	_, found := keeper.GetRole(suite.ctx, role.Name)
+	suite.Require().False(found)
+	_, err = msgServer.DeleteRole(suite.ctx, types.NewMsgDeleteRole(admin.String(), types.RoleBridgeOperator))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestPermissionsQuery() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+
+	var users []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		priv, err := ethsecp256k1.GenerateKey()
+		suite.Require().NoError(err)
+		user := sdk.AccAddress(priv.PubKey().Address())
+		keeper.SetPermissions(suite.ctx, user, All)
+		users = append(users, user)
+	}
+
+	rsp, err := keeper.Permissions(suite.ctx, &types.QueryPermissionsRequest{Address: users[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().True(rsp.CanChangeTokenMapping)
+	suite.Require().True(rsp.CanTurnBridge)
+	suite.Require().Len(rsp.Grants, 2)
+
+	var grants []types.RoleGrant
+	var nextKey []byte
+	for {
+		rsp, err = keeper.Permissions(suite.ctx, &types.QueryPermissionsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 4},
+		})
+		suite.Require().NoError(err)
+		grants = append(grants, rsp.Grants...)
+		nextKey = rsp.Pagination.NextKey
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+	suite.Require().Len(grants, 6)
+}
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/bank"
+	cronostypes "github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -25,6 +26,10 @@ const (
 	BurnMethodName      = "burn"
 	BalanceOfMethodName = "balanceOf"
 	TransferMethodName  = "transfer"
+	// allowances of the native coins
+	ApproveMethodName      = "approve"
+	AllowanceMethodName    = "allowance"
+	TransferFromMethodName = "transferFrom"
 )
 
 var (
@@ -42,11 +47,17 @@ func init() {
 		copy(methodID[:], bankABI.Methods[methodName].ID[:4])
 		switch methodName {
 		case MintMethodName, BurnMethodName:
-			bankGasRequiredByMethod[methodID] = 200000
+			bankGasRequiredByMethod[methodID] = 20000
 		case BalanceOfMethodName:
-			bankGasRequiredByMethod[methodID] = 10000
+			bankGasRequiredByMethod[methodID] = 1000
 		case TransferMethodName:
-			bankGasRequiredByMethod[methodID] = 150000
+			bankGasRequiredByMethod[methodID] = 15000
+		case ApproveMethodName:
+			bankGasRequiredByMethod[methodID] = 3000
+		case AllowanceMethodName:
+			bankGasRequiredByMethod[methodID] = 1000
+		case TransferFromMethodName:
+			bankGasRequiredByMethod[methodID] = 16000
 		default:
 			bankGasRequiredByMethod[methodID] = 0
 		}
@@ -58,21 +69,36 @@ func EVMDenom(token common.Address) string {
 }
 
 type BankContract struct {
-	bankKeeper  types.BankKeeper
-	cdc         codec.Codec
-	kvGasConfig storetypes.GasConfig
+	BaseContract
+
+	bankKeeper   types.BankKeeper
+	cronosKeeper cronostypes.CronosKeeper
+	cdc          codec.Codec
+	kvGasConfig  storetypes.GasConfig
 }
 
-// NewBankContract creates the precompiled contract to manage native tokens
-func NewBankContract(bankKeeper types.BankKeeper, cdc codec.Codec, kvGasConfig storetypes.GasConfig) vm.PrecompiledContract {
-	return &BankContract{bankKeeper, cdc, kvGasConfig}
+// NewBankContract creates the precompiled contract to manage native tokens,
+// it's only callable when the `enable_bank_precompile` parameter is turned on.
+func NewBankContract(
+	bankKeeper types.BankKeeper,
+	cronosKeeper cronostypes.CronosKeeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) vm.PrecompiledContract {
+	return &BankContract{
+		BaseContract: NewBaseContract(bankContractAddress),
+		bankKeeper:   bankKeeper,
+		cronosKeeper: cronosKeeper,
+		cdc:          cdc,
+		kvGasConfig:  kvGasConfig,
+	}
 }
 
 func (bc *BankContract) Address() common.Address {
 	return bankContractAddress
 }
 
-// RequiredGas calculates the contract gas use
+// RequiredGas calculates the floor of the contract gas use, the gas consumed by the native action is charged in `Run`
 func (bc *BankContract) RequiredGas(input []byte) uint64 {
 	// base cost to prevent large input size
 	baseCost := uint64(len(input)) * bc.kvGasConfig.WriteCostPerByte
@@ -97,14 +123,19 @@ func (bc *BankContract) checkBlockedAddr(addr sdk.AccAddress) error {
 }
 
 func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB := evm.StateDB.(ExtStateDB)
+	if !bc.cronosKeeper.GetParams(stateDB.Context()).EnableBankPrecompile {
+		return nil, errors.New("the bank precompile is disabled")
+	}
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
 	// parse input
 	methodID := contract.Input[:4]
 	method, err := bankABI.MethodById(methodID)
 	if err != nil {
 		return nil, err
 	}
-	stateDB := evm.StateDB.(ExtStateDB)
-	precompileAddr := bc.Address()
 	switch method.Name {
 	case MintMethodName, BurnMethodName:
 		if readonly {
@@ -125,7 +156,7 @@ func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) (
 		}
 		denom := EVMDenom(contract.CallerAddress)
 		amt := sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))
-		err = stateDB.ExecuteNativeAction(precompileAddr, nil, func(ctx sdk.Context) error {
+		err = executeNativeAction(stateDB, contract, nil, func(ctx sdk.Context) error {
 			if err := bc.bankKeeper.IsSendEnabledCoins(ctx, amt); err != nil {
 				return err
 			}
@@ -158,7 +189,13 @@ func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) (
 		token := args[0].(common.Address)
 		addr := args[1].(common.Address)
 		// query from storage
-		balance := bc.bankKeeper.GetBalance(stateDB.Context(), sdk.AccAddress(addr.Bytes()), EVMDenom(token)).Amount.BigInt()
+		var balance *big.Int
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			balance = bc.bankKeeper.GetBalance(ctx, sdk.AccAddress(addr.Bytes()), EVMDenom(token)).Amount.BigInt()
+			return nil
+		}); err != nil {
+			return nil, err
+		}
 		return method.Outputs.Pack(balance)
 	case TransferMethodName:
 		if readonly {
@@ -181,7 +218,84 @@ func (bc *BankContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) (
 		}
 		denom := EVMDenom(contract.CallerAddress)
 		amt := sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))
-		err = stateDB.ExecuteNativeAction(precompileAddr, nil, func(ctx sdk.Context) error {
+		err = executeNativeAction(stateDB, contract, nil, func(ctx sdk.Context) error {
+			if err := bc.bankKeeper.IsSendEnabledCoins(ctx, amt); err != nil {
+				return err
+			}
+			if err := bc.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amt)); err != nil {
+				return errorsmod.Wrap(err, "fail to send coins in precompiled contract")
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case ApproveMethodName:
+		if readonly {
+			return nil, errors.New("the method is not readonly")
+		}
+		args, err := method.Inputs.Unpack(contract.Input[4:])
+		if err != nil {
+			return nil, errors.New("fail to unpack input arguments")
+		}
+		token := args[0].(common.Address)
+		spender := args[1].(common.Address)
+		amount := args[2].(*big.Int)
+		if amount.Sign() < 0 {
+			return nil, errors.New("invalid amount")
+		}
+		err = executeNativeAction(stateDB, contract, nil, func(ctx sdk.Context) error {
+			bc.cronosKeeper.SetBankAllowance(ctx, token, contract.CallerAddress, spender, amount)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case AllowanceMethodName:
+		args, err := method.Inputs.Unpack(contract.Input[4:])
+		if err != nil {
+			return nil, errors.New("fail to unpack input arguments")
+		}
+		token := args[0].(common.Address)
+		owner := args[1].(common.Address)
+		spender := args[2].(common.Address)
+		var allowance *big.Int
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			allowance = bc.cronosKeeper.GetBankAllowance(ctx, token, owner, spender)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(allowance)
+	case TransferFromMethodName:
+		if readonly {
+			return nil, errors.New("the method is not readonly")
+		}
+		args, err := method.Inputs.Unpack(contract.Input[4:])
+		if err != nil {
+			return nil, errors.New("fail to unpack input arguments")
+		}
+		token := args[0].(common.Address)
+		owner := args[1].(common.Address)
+		recipient := args[2].(common.Address)
+		amount := args[3].(*big.Int)
+		if amount.Sign() <= 0 {
+			return nil, errors.New("invalid amount")
+		}
+		from := sdk.AccAddress(owner.Bytes())
+		to := sdk.AccAddress(recipient.Bytes())
+		if err := bc.checkBlockedAddr(to); err != nil {
+			return nil, err
+		}
+		amt := sdk.NewCoin(EVMDenom(token), sdkmath.NewIntFromBigInt(amount))
+		err = executeNativeAction(stateDB, contract, nil, func(ctx sdk.Context) error {
+			allowance := bc.cronosKeeper.GetBankAllowance(ctx, token, owner, contract.CallerAddress)
+			if allowance.Cmp(amount) < 0 {
+				return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "insufficient allowance: %s < %s", allowance, amount)
+			}
+			bc.cronosKeeper.SetBankAllowance(ctx, token, owner, contract.CallerAddress, allowance.Sub(allowance, amount))
 			if err := bc.bankKeeper.IsSendEnabledCoins(ctx, amt); err != nil {
 				return err
 			}
@@ -0,0 +1,157 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/e2ee"
+	e2eetypes "github.com/crypto-org-chain/cronos/v2/x/e2ee/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	RegisterEncryptionKeyMethodName = "registerEncryptionKey"
+	KeyMethodName                   = "key"
+	KeysMethodName                  = "keys"
+)
+
+var (
+	e2eeABI                 abi.ABI
+	e2eeContractAddress     = common.BytesToAddress([]byte{107})
+	e2eeGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	if err := e2eeABI.UnmarshalJSON([]byte(e2ee.E2EEModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range e2eeABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], e2eeABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case RegisterEncryptionKeyMethodName:
+			e2eeGasRequiredByMethod[methodID] = 5000
+		case KeyMethodName, KeysMethodName:
+			e2eeGasRequiredByMethod[methodID] = 1000
+		default:
+			e2eeGasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+// E2EEContract is the precompiled contract to register and look up the encryption keys of the e2ee module, the key
+// is registered for the caller.
+type E2EEContract struct {
+	BaseContract
+
+	e2eeMsgServer   e2eetypes.MsgServer
+	e2eeQueryServer e2eetypes.QueryServer
+	kvGasConfig     storetypes.GasConfig
+}
+
+func NewE2EEContract(
+	e2eeMsgServer e2eetypes.MsgServer,
+	e2eeQueryServer e2eetypes.QueryServer,
+	kvGasConfig storetypes.GasConfig,
+) vm.PrecompiledContract {
+	return &E2EEContract{
+		BaseContract:    NewBaseContract(e2eeContractAddress),
+		e2eeMsgServer:   e2eeMsgServer,
+		e2eeQueryServer: e2eeQueryServer,
+		kvGasConfig:     kvGasConfig,
+	}
+}
+
+func (ec *E2EEContract) Address() common.Address {
+	return e2eeContractAddress
+}
+
+// RequiredGas calculates the floor of the contract gas use, the gas consumed by the native action is charged in `Run`
+func (ec *E2EEContract) RequiredGas(input []byte) uint64 {
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * ec.kvGasConfig.WriteCostPerByte
+	var methodID [4]byte
+	copy(methodID[:], input)
+	requiredGas, ok := e2eeGasRequiredByMethod[methodID]
+	if ok {
+		return requiredGas + baseCost
+	}
+	return baseCost
+}
+
+func (ec *E2EEContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
+	// parse input
+	method, err := e2eeABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	stateDB := evm.StateDB.(ExtStateDB)
+
+	switch method.Name {
+	case RegisterEncryptionKeyMethodName:
+		if readonly {
+			return nil, errors.New("the method is not readonly")
+		}
+		msg := &e2eetypes.MsgRegisterEncryptionKey{
+			Address: sdk.AccAddress(contract.CallerAddress.Bytes()).String(),
+			Key:     args[0].(string),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if err := executeNativeAction(stateDB, contract, nil, func(ctx sdk.Context) error {
+			_, err := ec.e2eeMsgServer.RegisterEncryptionKey(ctx, msg)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(true)
+	case KeyMethodName:
+		var key string
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			rsp, err := ec.e2eeQueryServer.Key(ctx, &e2eetypes.KeyRequest{
+				Address: sdk.AccAddress(args[0].(common.Address).Bytes()).String(),
+			})
+			if err != nil {
+				return err
+			}
+			key = rsp.Key
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(key)
+	case KeysMethodName:
+		addrs := args[0].([]common.Address)
+		req := &e2eetypes.KeysRequest{Addresses: make([]string, len(addrs))}
+		for i, addr := range addrs {
+			req.Addresses[i] = sdk.AccAddress(addr.Bytes()).String()
+		}
+		var keys []string
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			rsp, err := ec.e2eeQueryServer.Keys(ctx, req)
+			if err != nil {
+				return err
+			}
+			keys = rsp.Keys
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(keys)
+	default:
+		return nil, fmt.Errorf("unknown method: %s", method.Name)
+	}
+}
@@ -0,0 +1,175 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cronosevents "github.com/crypto-org-chain/cronos/v2/x/cronos/events"
+	execbinding "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/exec"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	ExecMethodName = "exec"
+)
+
+var (
+	execABI                 abi.ABI
+	execContractAddress     = common.BytesToAddress([]byte{106})
+	execGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	if err := execABI.UnmarshalJSON([]byte(execbinding.ExecModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range execABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], execABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case ExecMethodName:
+			execGasRequiredByMethod[methodID] = 10000
+		default:
+			execGasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+// ExecContract is the precompiled contract for the EVM contracts to execute the native messages on behalf of
+// themselves, the inputs are the protobuf encoded `Any` messages, the only signer of each message must be the caller,
+// and the message types must be allowed by the `exec_msg_type_urls` parameter.
+type ExecContract struct {
+	BaseContract
+
+	cdc          codec.Codec
+	router       types.MsgServiceRouter
+	cronosKeeper types.CronosKeeper
+	kvGasConfig  storetypes.GasConfig
+}
+
+func NewExecContract(
+	router types.MsgServiceRouter,
+	cronosKeeper types.CronosKeeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) vm.PrecompiledContract {
+	return &ExecContract{
+		BaseContract: NewBaseContract(execContractAddress),
+		cdc:          cdc,
+		router:       router,
+		cronosKeeper: cronosKeeper,
+		kvGasConfig:  kvGasConfig,
+	}
+}
+
+func (ec *ExecContract) Address() common.Address {
+	return execContractAddress
+}
+
+// RequiredGas calculates the floor of the contract gas use, the gas consumed by the native action is charged in `Run`
+func (ec *ExecContract) RequiredGas(input []byte) uint64 {
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * ec.kvGasConfig.WriteCostPerByte
+	var methodID [4]byte
+	copy(methodID[:], input)
+	requiredGas, ok := execGasRequiredByMethod[methodID]
+	if ok {
+		return requiredGas + baseCost
+	}
+	return baseCost
+}
+
+func (ec *ExecContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if readonly {
+		return nil, errors.New("the method is not readonly")
+	}
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
+	// parse input
+	method, err := execABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name != ExecMethodName {
+		return nil, fmt.Errorf("unknown method: %s", method.Name)
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	inputs := args[0].([][]byte)
+	stateDB := evm.StateDB.(ExtStateDB)
+
+	allowed := make(map[string]struct{})
+	for _, url := range ec.cronosKeeper.GetParams(stateDB.Context()).ExecMsgTypeUrls {
+		allowed[url] = struct{}{}
+	}
+	msgs := make([]sdk.Msg, len(inputs))
+	for i, input := range inputs {
+		msg, err := ec.decodeMsg(input, allowed, contract.CallerAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+		msgs[i] = msg
+	}
+
+	responses := make([][]byte, len(msgs))
+	if err := executeNativeAction(stateDB, contract, cronosevents.ExecConvertEvent, func(ctx sdk.Context) error {
+		for i, msg := range msgs {
+			handler := ec.router.Handler(msg)
+			if handler == nil {
+				return fmt.Errorf("no message handler found for %s", sdk.MsgTypeURL(msg))
+			}
+			res, err := handler(ctx, msg)
+			if err != nil {
+				return err
+			}
+			ctx.EventManager().EmitEvents(res.GetEvents())
+			if len(res.MsgResponses) > 0 {
+				if responses[i], err = ec.cdc.Marshal(res.MsgResponses[0]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(responses)
+}
+
+// decodeMsg decodes the `Any` message and checks the message type is allowed and the only signer is the caller.
+func (ec *ExecContract) decodeMsg(input []byte, allowed map[string]struct{}, caller common.Address) (sdk.Msg, error) {
+	var msg sdk.Msg
+	if err := ec.cdc.UnmarshalInterface(input, &msg); err != nil {
+		return nil, err
+	}
+	if _, ok := allowed[sdk.MsgTypeURL(msg)]; !ok {
+		return nil, fmt.Errorf("message type not allowed: %s", sdk.MsgTypeURL(msg))
+	}
+	signers, err := getSigners(ec.cdc, msg)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get signers of %T %w", msg, err)
+	}
+	if len(signers) != 1 {
+		return nil, errors.New("don't support multi-signers message")
+	}
+	signer := common.BytesToAddress(signers[0])
+	if signer != caller {
+		return nil, fmt.Errorf("caller is not authenticated: expected %s, got %s", caller.Hex(), signer.Hex())
+	}
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
@@ -0,0 +1,113 @@
+package precompiles_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crypto-org-chain/cronos/v2/app"
+	execbinding "github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/exec"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/precompiles"
+)
+
+func packExecInput(t *testing.T, a *app.App, msgs ...sdk.Msg) []byte {
+	t.Helper()
+	abi, err := execbinding.ExecModuleMetaData.GetAbi()
+	require.NoError(t, err)
+	inputs := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		bz, err := a.AppCodec().MarshalInterface(msg)
+		require.NoError(t, err)
+		inputs[i] = bz
+	}
+	input, err := abi.Pack(precompiles.ExecMethodName, inputs)
+	require.NoError(t, err)
+	return input
+}
+
+func TestExecContract(t *testing.T) {
+	a, ctx := setupTest(t)
+	params := a.CronosKeeper.GetParams(ctx)
+	params.ExecMsgTypeUrls = []string{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	}
+	require.NoError(t, a.CronosKeeper.SetParams(ctx, params))
+
+	caller := newAccount(t)
+	other := newAccount(t)
+	coin := fundAccount(t, a, ctx, caller, 1000)
+	fundAccount(t, a, ctx, other, 1000)
+	callerAddr := sdk.AccAddress(caller.Bytes())
+	otherAddr := sdk.AccAddress(other.Bytes())
+	recipient := sdk.AccAddress(newAccount(t).Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(coin.Denom, 100))
+	contract := precompiles.NewExecContract(a.MsgServiceRouter(), &a.CronosKeeper, a.AppCodec(), gasConfig)
+
+	testCases := []struct {
+		name   string
+		caller common.Address
+		msgs   []sdk.Msg
+		expErr string
+	}{
+		{
+			"not authenticated",
+			other,
+			[]sdk.Msg{banktypes.NewMsgSend(callerAddr, recipient, amount)},
+			"caller is not authenticated",
+		},
+		{
+			"one of the messages not authenticated",
+			caller,
+			[]sdk.Msg{
+				banktypes.NewMsgSend(callerAddr, recipient, amount),
+				banktypes.NewMsgSend(otherAddr, recipient, amount),
+			},
+			"caller is not authenticated",
+		},
+		{
+			"message type not allowed",
+			caller,
+			[]sdk.Msg{stakingtypes.NewMsgDelegate(callerAddr.String(), sdk.ValAddress(other.Bytes()).String(), coin)},
+			"message type not allowed",
+		},
+		{
+			"multi-signers message",
+			caller,
+			[]sdk.Msg{&banktypes.MsgMultiSend{
+				Inputs:  []banktypes.Input{banktypes.NewInput(callerAddr, amount), banktypes.NewInput(otherAddr, amount)},
+				Outputs: []banktypes.Output{banktypes.NewOutput(recipient, amount.Add(amount...))},
+			}},
+			"don't support multi-signers message",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := packExecInput(t, a, tc.msgs...)
+			evm, _ := newEVM(a, ctx)
+			_, _, err := runPrecompile(evm, contract, tc.caller, input, contract.RequiredGas(input)+1000000, false)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	input := packExecInput(t, a,
+		banktypes.NewMsgSend(callerAddr, recipient, amount),
+		banktypes.NewMsgSend(callerAddr, recipient, amount),
+	)
+
+	// not allowed in static call
+	evm, _ := newEVM(a, ctx)
+	_, _, err := runPrecompile(evm, contract, caller, input, contract.RequiredGas(input)+1000000, true)
+	require.ErrorContains(t, err, "the method is not readonly")
+
+	evm, stateDB := newEVM(a, ctx)
+	ret, _, err := runPrecompile(evm, contract, caller, input, contract.RequiredGas(input)+1000000, false)
+	require.NoError(t, err)
+	require.NotEmpty(t, ret)
+	require.NoError(t, stateDB.Commit())
+	require.Equal(t, amount.Add(amount...), a.BankKeeper.GetAllBalances(ctx, recipient))
+}
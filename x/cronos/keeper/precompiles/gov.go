@@ -0,0 +1,176 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cronosevents "github.com/crypto-org-chain/cronos/v2/x/cronos/events"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/gov"
+)
+
+const (
+	SubmitProposalMethodName = "submitProposal"
+	DepositMethodName        = "deposit"
+	VoteMethodName           = "vote"
+	VoteWeightedMethodName   = "voteWeighted"
+	GetProposalMethodName    = "getProposal"
+	GetTallyResultMethodName = "getTallyResult"
+)
+
+var (
+	govABI                 abi.ABI
+	govContractAddress     = common.BytesToAddress([]byte{105})
+	govGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	if err := govABI.UnmarshalJSON([]byte(gov.GovModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range govABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], govABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case SubmitProposalMethodName:
+			govGasRequiredByMethod[methodID] = 30000
+		case DepositMethodName:
+			govGasRequiredByMethod[methodID] = 15000
+		case VoteMethodName:
+			govGasRequiredByMethod[methodID] = 10000
+		case VoteWeightedMethodName:
+			govGasRequiredByMethod[methodID] = 12000
+		case GetProposalMethodName:
+			govGasRequiredByMethod[methodID] = 3000
+		case GetTallyResultMethodName:
+			govGasRequiredByMethod[methodID] = 10000
+		default:
+			govGasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+// GovContract is the precompiled contract for the EVM contracts to participate in the chain governance, the inputs
+// are the protobuf encoded gov messages, the proposer, depositor or voter must be the caller.
+type GovContract struct {
+	BaseContract
+
+	cdc            codec.Codec
+	govMsgServer   govv1.MsgServer
+	govQueryServer govv1.QueryServer
+	kvGasConfig    storetypes.GasConfig
+}
+
+func NewGovContract(
+	govMsgServer govv1.MsgServer,
+	govQueryServer govv1.QueryServer,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) vm.PrecompiledContract {
+	return &GovContract{
+		BaseContract:   NewBaseContract(govContractAddress),
+		cdc:            cdc,
+		govMsgServer:   govMsgServer,
+		govQueryServer: govQueryServer,
+		kvGasConfig:    kvGasConfig,
+	}
+}
+
+func (gc *GovContract) Address() common.Address {
+	return govContractAddress
+}
+
+// RequiredGas calculates the floor of the contract gas use, the gas consumed by the native action is charged in `Run`
+func (gc *GovContract) RequiredGas(input []byte) uint64 {
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * gc.kvGasConfig.WriteCostPerByte
+	var methodID [4]byte
+	copy(methodID[:], input)
+	requiredGas, ok := govGasRequiredByMethod[methodID]
+	if ok {
+		return requiredGas + baseCost
+	}
+	return baseCost
+}
+
+func (gc *GovContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
+	// parse input
+	method, err := govABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	stateDB := evm.StateDB.(ExtStateDB)
+
+	var res []byte
+	switch method.Name {
+	case GetProposalMethodName:
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			rsp, err := gc.govQueryServer.Proposal(ctx, &govv1.QueryProposalRequest{
+				ProposalId: args[0].(uint64),
+			})
+			if err != nil {
+				return err
+			}
+			res, err = gc.cdc.Marshal(rsp.Proposal)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(res)
+	case GetTallyResultMethodName:
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			rsp, err := gc.govQueryServer.TallyResult(ctx, &govv1.QueryTallyResultRequest{
+				ProposalId: args[0].(uint64),
+			})
+			if err != nil {
+				return err
+			}
+			res, err = gc.cdc.Marshal(rsp.Tally)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(res)
+	}
+
+	if readonly {
+		return nil, errors.New("the method is not readonly")
+	}
+	e := &Executor{
+		cdc:       gc.cdc,
+		stateDB:   stateDB,
+		contract:  contract,
+		input:     args[0].([]byte),
+		converter: cronosevents.GovConvertEvent,
+	}
+	switch method.Name {
+	case SubmitProposalMethodName:
+		res, err = exec(e, gc.govMsgServer.SubmitProposal)
+	case DepositMethodName:
+		res, err = exec(e, gc.govMsgServer.Deposit)
+	case VoteMethodName:
+		res, err = exec(e, gc.govMsgServer.Vote)
+	case VoteWeightedMethodName:
+		res, err = exec(e, gc.govMsgServer.VoteWeighted)
+	default:
+		return nil, fmt.Errorf("unknown method: %s", method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(res)
+}
@@ -0,0 +1,81 @@
+package precompiles_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crypto-org-chain/cronos/v2/app"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/gov"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/precompiles"
+)
+
+func newGovContract(a *app.App) vm.PrecompiledContract {
+	return precompiles.NewGovContract(
+		govkeeper.NewMsgServerImpl(&a.GovKeeper),
+		govkeeper.NewQueryServer(&a.GovKeeper),
+		a.AppCodec(),
+		gasConfig,
+	)
+}
+
+func TestGovContract(t *testing.T) {
+	a, ctx := setupTest(t)
+	abi, err := gov.GovModuleMetaData.GetAbi()
+	require.NoError(t, err)
+
+	caller := newAccount(t)
+	other := newAccount(t)
+	voter := sdk.AccAddress(caller.Bytes())
+	contract := newGovContract(a)
+
+	proposal, err := a.GovKeeper.SubmitProposal(ctx, nil, "", "title", "summary", voter, false)
+	require.NoError(t, err)
+	require.NoError(t, a.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	bz, err := a.AppCodec().Marshal(govv1.NewMsgVote(voter, proposal.Id, govv1.OptionYes, ""))
+	require.NoError(t, err)
+	vote, err := abi.Pack(precompiles.VoteMethodName, bz)
+	require.NoError(t, err)
+
+	// the voter must be the caller
+	evm, _ := newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, other, vote, contract.RequiredGas(vote)+1000000, false)
+	require.ErrorContains(t, err, "caller is not authenticated")
+
+	// not allowed in static call
+	_, _, err = runPrecompile(evm, contract, caller, vote, contract.RequiredGas(vote)+1000000, true)
+	require.ErrorContains(t, err, "the method is not readonly")
+
+	evm, stateDB := newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, caller, vote, contract.RequiredGas(vote)+1000000, false)
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+	stored, err := a.GovKeeper.Votes.Get(ctx, collections.Join(proposal.Id, voter))
+	require.NoError(t, err)
+	require.Equal(t, govv1.OptionYes, stored.Options[0].Option)
+
+	// the queries are allowed in static call, for any caller
+	getProposal, err := abi.Pack(precompiles.GetProposalMethodName, proposal.Id)
+	require.NoError(t, err)
+	evm, _ = newEVM(a, ctx)
+	ret, _, err := runPrecompile(evm, contract, other, getProposal, contract.RequiredGas(getProposal)+1000000, true)
+	require.NoError(t, err)
+	unpacked, err := abi.Unpack(precompiles.GetProposalMethodName, ret)
+	require.NoError(t, err)
+	var queried govv1.Proposal
+	require.NoError(t, a.AppCodec().Unmarshal(unpacked[0].([]byte), &queried))
+	require.Equal(t, proposal.Id, queried.Id)
+	require.Equal(t, "title", queried.Title)
+
+	// unknown proposal
+	getProposal, err = abi.Pack(precompiles.GetProposalMethodName, proposal.Id+1)
+	require.NoError(t, err)
+	_, _, err = runPrecompile(evm, contract, other, getProposal, contract.RequiredGas(getProposal)+1000000, true)
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -25,17 +26,32 @@ import (
 )
 
 const (
-	RegisterAccountMethodName = "registerAccount"
-	QueryAccountMethodName    = "queryAccount"
-	SubmitMsgsMethodName      = "submitMsgs"
+	RegisterAccountMethodName                = "registerAccount"
+	RegisterAccountWithOwnerIDMethodName     = "registerAccountWithOwnerID"
+	ReopenChannelMethodName                  = "reopenChannel"
+	QueryAccountMethodName                   = "queryAccount"
+	QueryAccountWithOwnerIDMethodName        = "queryAccountWithOwnerID"
+	QueryActiveChannelMethodName             = "queryActiveChannel"
+	SubmitMsgsMethodName                     = "submitMsgs"
+	SubmitMsgsWithOwnerIDMethodName          = "submitMsgsWithOwnerID"
+	SubmitMsgsWithTimeoutTimestampMethodName = "submitMsgsWithTimeoutTimestamp"
+	DecodeTxMsgResultsMethodName             = "decodeTxMsgResults"
+
+	// IcaOwnerSeparator separates the caller and the owner id in the owner of an interchain account
+	IcaOwnerSeparator = "."
 )
 
 var (
 	icaABI                 abi.ABI
 	icaCallbackABI         abi.ABI
+	icaCallbackV2ABI       abi.ABI
 	icaContractAddress     = common.BytesToAddress([]byte{102})
 	icaMethodNamesByID     = map[[4]byte]string{}
 	icaGasRequiredByMethod = map[[4]byte]uint64{}
+
+	// ICACallbackV2InterfaceID is the ERC-165 interface id of IICACallbackV2, the contracts reporting it through
+	// `supportsInterface` receive the packet results through `onPacketResultCallbackV2`.
+	ICACallbackV2InterfaceID [4]byte
 )
 
 func init() {
@@ -45,17 +61,23 @@ func init() {
 	if err := icaCallbackABI.UnmarshalJSON([]byte(icacallback.ICACallbackMetaData.ABI)); err != nil {
 		panic(err)
 	}
+	if err := icaCallbackV2ABI.UnmarshalJSON([]byte(icacallback.ICACallbackV2MetaData.ABI)); err != nil {
+		panic(err)
+	}
+	copy(ICACallbackV2InterfaceID[:], icaCallbackV2ABI.Methods["onPacketResultCallbackV2"].ID[:4])
 
 	for methodName := range icaABI.Methods {
 		var methodID [4]byte
 		copy(methodID[:], icaABI.Methods[methodName].ID[:4])
 		switch methodName {
-		case RegisterAccountMethodName:
-			icaGasRequiredByMethod[methodID] = 300000
-		case QueryAccountMethodName:
-			icaGasRequiredByMethod[methodID] = 100000
-		case SubmitMsgsMethodName:
-			icaGasRequiredByMethod[methodID] = 300000
+		case RegisterAccountMethodName, RegisterAccountWithOwnerIDMethodName, ReopenChannelMethodName:
+			icaGasRequiredByMethod[methodID] = 30000
+		case QueryAccountMethodName, QueryAccountWithOwnerIDMethodName, QueryActiveChannelMethodName:
+			icaGasRequiredByMethod[methodID] = 10000
+		case SubmitMsgsMethodName, SubmitMsgsWithOwnerIDMethodName, SubmitMsgsWithTimeoutTimestampMethodName:
+			icaGasRequiredByMethod[methodID] = 30000
+		case DecodeTxMsgResultsMethodName:
+			icaGasRequiredByMethod[methodID] = 1000
 		default:
 			icaGasRequiredByMethod[methodID] = 0
 		}
@@ -63,16 +85,55 @@ func init() {
 	}
 }
 
+// icaOwner returns the owner of the interchain account controlled by the caller, the non-empty owner id allows a
+// caller to control multiple interchain accounts on the same connection.
+func icaOwner(caller common.Address, ownerID string) string {
+	owner := sdk.AccAddress(caller.Bytes()).String()
+	if len(ownerID) == 0 {
+		return owner
+	}
+	return owner + IcaOwnerSeparator + ownerID
+}
+
+// IcaOwnerAccount returns the bech32 address of the caller which controls the interchain account of the owner.
+func IcaOwnerAccount(owner string) string {
+	account, _, _ := strings.Cut(owner, IcaOwnerSeparator)
+	return account
+}
+
 func OnPacketResultCallback(args ...interface{}) ([]byte, error) {
 	return icaCallbackABI.Pack("onPacketResultCallback", args...)
 }
 
+func OnPacketResultCallbackV2(args ...interface{}) ([]byte, error) {
+	return icaCallbackV2ABI.Pack("onPacketResultCallbackV2", args...)
+}
+
+// SupportsICACallbackV2 packs the ERC-165 query of the IICACallbackV2 interface.
+func SupportsICACallbackV2() ([]byte, error) {
+	return icaCallbackV2ABI.Pack("supportsInterface", ICACallbackV2InterfaceID)
+}
+
+// UnpackSupportsInterface decodes the result of the ERC-165 query.
+func UnpackSupportsInterface(ret []byte) (bool, error) {
+	res, err := icaCallbackV2ABI.Unpack("supportsInterface", ret)
+	if err != nil {
+		return false, err
+	}
+	supported, ok := res[0].(bool)
+	if !ok {
+		return false, errors.New("invalid supportsInterface result")
+	}
+	return supported, nil
+}
+
 type IcaContract struct {
 	BaseContract
 
 	ctx              sdk.Context
 	cdc              codec.Codec
 	controllerKeeper icacontrollerkeeper.Keeper
+	channelKeeper    types.ChannelKeeper
 	cronosKeeper     types.CronosKeeper
 	kvGasConfig      storetypes.GasConfig
 }
@@ -80,6 +141,7 @@ type IcaContract struct {
 func NewIcaContract(
 	ctx sdk.Context,
 	controllerKeeper icacontrollerkeeper.Keeper,
+	channelKeeper types.ChannelKeeper,
 	cronosKeeper types.CronosKeeper,
 	cdc codec.Codec,
 	kvGasConfig storetypes.GasConfig,
@@ -89,6 +151,7 @@ func NewIcaContract(
 		ctx:              ctx,
 		cdc:              cdc,
 		controllerKeeper: controllerKeeper,
+		channelKeeper:    channelKeeper,
 		cronosKeeper:     cronosKeeper,
 		kvGasConfig:      kvGasConfig,
 	}
@@ -98,14 +161,15 @@ func (ic *IcaContract) Address() common.Address {
 	return icaContractAddress
 }
 
-// RequiredGas calculates the contract gas use
+// RequiredGas calculates the floor of the contract gas use, the gas consumed by the native action is charged in `Run`
 func (ic *IcaContract) RequiredGas(input []byte) uint64 {
 	// base cost to prevent large input size
 	baseCost := uint64(len(input)) * ic.kvGasConfig.WriteCostPerByte
 	var methodID [4]byte
-	copy(methodID[:], input[:4])
+	copy(methodID[:], input)
 	requiredGas, ok := icaGasRequiredByMethod[methodID]
-	if icaMethodNamesByID[methodID] == SubmitMsgsMethodName {
+	switch icaMethodNamesByID[methodID] {
+	case SubmitMsgsMethodName, SubmitMsgsWithOwnerIDMethodName, SubmitMsgsWithTimeoutTimestampMethodName:
 		requiredGas += ic.cronosKeeper.GetParams(ic.ctx).MaxCallbackGas
 	}
 	if ok {
@@ -115,31 +179,45 @@ func (ic *IcaContract) RequiredGas(input []byte) uint64 {
 }
 
 func (ic *IcaContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
 	// parse input
 	methodID := contract.Input[:4]
 	method, err := icaABI.MethodById(methodID)
 	if err != nil {
 		return nil, err
 	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
 	stateDB := evm.StateDB.(ExtStateDB)
-	precompileAddr := ic.Address()
 	caller := contract.CallerAddress
-	owner := sdk.AccAddress(caller.Bytes()).String()
-	converter := cronosevents.IcaConvertEvent
-	var execErr error
 	switch method.Name {
-	case RegisterAccountMethodName:
+	case RegisterAccountMethodName, RegisterAccountWithOwnerIDMethodName, ReopenChannelMethodName:
 		if readonly {
 			return nil, errors.New("the method is not readonly")
 		}
-		args, err := method.Inputs.Unpack(contract.Input[4:])
-		if err != nil {
-			return nil, errors.New("fail to unpack input arguments")
+		var connectionID, ownerID, version string
+		var ordering int32
+		if method.Name == RegisterAccountMethodName {
+			connectionID, version, ordering = args[0].(string), args[1].(string), args[2].(int32)
+		} else {
+			connectionID, ownerID, version, ordering = args[0].(string), args[1].(string), args[2].(string), args[3].(int32)
 		}
-		connectionID := args[0].(string)
-		version := args[1].(string)
-		ordering := args[2].(int32)
-		execErr = stateDB.ExecuteNativeAction(precompileAddr, converter, func(ctx sdk.Context) error {
+		owner := icaOwner(caller, ownerID)
+		if err := executeNativeAction(stateDB, contract, cronosevents.IcaConvertEvent, func(ctx sdk.Context) error {
+			if method.Name == ReopenChannelMethodName {
+				// the registration opens a new channel for the account, but only after the active one is closed
+				portID, err := icatypes.NewControllerPortID(owner)
+				if err != nil {
+					return err
+				}
+				if !ic.controllerKeeper.IsActiveChannelClosed(ctx, connectionID, portID) {
+					return errors.New("the active channel is not closed")
+				}
+			}
 			msgServer := icacontrollerkeeper.NewMsgServerImpl(&ic.controllerKeeper)
 			_, err := msgServer.RegisterInterchainAccount(ctx, &icacontrollertypes.MsgRegisterInterchainAccount{
 				Owner:        owner,
@@ -148,88 +226,174 @@ func (ic *IcaContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([
 				Ordering:     channeltypes.Order(ordering),
 			})
 			return err
-		})
-		if execErr != nil {
-			return nil, execErr
+		}); err != nil {
+			return nil, err
 		}
 		return method.Outputs.Pack(true)
-	case QueryAccountMethodName:
-		args, err := method.Inputs.Unpack(contract.Input[4:])
-		if err != nil {
-			return nil, errors.New("fail to unpack input arguments")
-		}
+	case QueryAccountMethodName, QueryAccountWithOwnerIDMethodName:
 		connectionID := args[0].(string)
 		account := args[1].(common.Address)
-		owner := sdk.AccAddress(account.Bytes()).String()
+		ownerID := ""
+		if method.Name == QueryAccountWithOwnerIDMethodName {
+			ownerID = args[2].(string)
+		}
 		icaAddress := ""
-		response, err := ic.controllerKeeper.InterchainAccount(
-			stateDB.Context(),
-			&icacontrollertypes.QueryInterchainAccountRequest{
-				Owner:        owner,
-				ConnectionId: connectionID,
-			})
-		if err != nil {
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			response, err := ic.controllerKeeper.InterchainAccount(
+				ctx,
+				&icacontrollertypes.QueryInterchainAccountRequest{
+					Owner:        icaOwner(account, ownerID),
+					ConnectionId: connectionID,
+				})
+			if err != nil {
+				return err
+			}
+			if response != nil {
+				icaAddress = response.Address
+			}
+			return nil
+		}); err != nil {
 			return nil, err
 		}
-		if response != nil {
-			icaAddress = response.Address
-		}
 		return method.Outputs.Pack(icaAddress)
-	case SubmitMsgsMethodName:
+	case QueryActiveChannelMethodName:
+		connectionID := args[0].(string)
+		account := args[1].(common.Address)
+		ownerID := args[2].(string)
+		channelID := ""
+		state := channeltypes.UNINITIALIZED
+		if err := query(stateDB, contract, func(ctx sdk.Context) error {
+			portID, err := icatypes.NewControllerPortID(icaOwner(account, ownerID))
+			if err != nil {
+				return err
+			}
+			activeChannelID, found := ic.controllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+			if !found {
+				return nil
+			}
+			channel, found := ic.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+			if !found {
+				return fmt.Errorf("channel not found: %s", activeChannelID)
+			}
+			channelID = activeChannelID
+			state = channel.State
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(channelID, int32(state))
+	case SubmitMsgsMethodName, SubmitMsgsWithOwnerIDMethodName, SubmitMsgsWithTimeoutTimestampMethodName:
 		if readonly {
 			return nil, errors.New("the method is not readonly")
 		}
-		args, err := method.Inputs.Unpack(contract.Input[4:])
+		var connectionID, ownerID string
+		var data []byte
+		var timeout func(ctx sdk.Context) (uint64, error)
+		switch method.Name {
+		case SubmitMsgsMethodName:
+			connectionID, data = args[0].(string), args[1].([]byte)
+			timeout = relativeTimeout(args[2].(*big.Int))
+		case SubmitMsgsWithOwnerIDMethodName:
+			connectionID, ownerID, data = args[0].(string), args[1].(string), args[2].([]byte)
+			timeout = relativeTimeout(args[3].(*big.Int))
+		default:
+			connectionID, ownerID, data = args[0].(string), args[1].(string), args[2].([]byte)
+			timeout = absoluteTimeout(args[3].(uint64))
+		}
+		seq, err := ic.submitMsgs(stateDB, contract, icaOwner(caller, ownerID), connectionID, data, timeout)
 		if err != nil {
-			return nil, errors.New("fail to unpack input arguments")
+			return nil, err
 		}
-		connectionID := args[0].(string)
-		data := args[1].([]byte)
-		timeout := args[2].(*big.Int)
-		icaMsgData := icatypes.InterchainAccountPacketData{
-			Type: icatypes.EXECUTE_TX,
-			Data: data,
-			Memo: fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, caller.String()),
-		}
-		seq := uint64(0)
-		execErr = stateDB.ExecuteNativeAction(precompileAddr, converter, func(ctx sdk.Context) error {
-			msgServer := icacontrollerkeeper.NewMsgServerImpl(&ic.controllerKeeper)
-			response, err := msgServer.SendTx(
-				ctx, &icacontrollertypes.MsgSendTx{
-					Owner:           owner,
-					ConnectionId:    connectionID,
-					RelativeTimeout: timeout.Uint64(),
-					PacketData:      icaMsgData,
-				},
-			)
-			if err == nil && response != nil {
-				seq = response.Sequence
-
-				// fetch src channel id for event
-				portID, err := icatypes.NewControllerPortID(owner)
-				if err != nil {
-					return err
-				}
-				activeChannelID, found := ic.controllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
-				if !found {
-					return errors.New("failed to retrieve active channel")
-				}
+		return method.Outputs.Pack(seq)
+	case DecodeTxMsgResultsMethodName:
+		var txMsgData sdk.TxMsgData
+		if err := ic.cdc.Unmarshal(args[0].([]byte), &txMsgData); err != nil {
+			return nil, err
+		}
+		typeURLs := make([]string, len(txMsgData.MsgResponses))
+		responses := make([][]byte, len(txMsgData.MsgResponses))
+		for i, rsp := range txMsgData.MsgResponses {
+			typeURLs[i] = rsp.TypeUrl
+			responses[i] = rsp.Value
+		}
+		return method.Outputs.Pack(typeURLs, responses)
+	default:
+		return nil, errors.New("unknown method")
+	}
+}
 
-				ctx.EventManager().EmitEvents(sdk.Events{
-					sdk.NewEvent(
-						cronoseventstypes.EventTypeSubmitMsgsResult,
-						sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, activeChannelID),
-						sdk.NewAttribute(cronoseventstypes.AttributeKeySeq, fmt.Sprintf("%d", response.Sequence)),
-					),
-				})
-			}
+// submitMsgs sends the tx packet on the active channel of the owner, the result of the packet is delivered back to
+// the caller through `onPacketResultCallback`, or `onPacketResultCallbackV2` if the caller supports IICACallbackV2.
+func (ic *IcaContract) submitMsgs(
+	stateDB ExtStateDB,
+	contract *vm.Contract,
+	owner, connectionID string,
+	data []byte,
+	timeout func(ctx sdk.Context) (uint64, error),
+) (uint64, error) {
+	icaMsgData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, contract.CallerAddress.String()),
+	}
+	seq := uint64(0)
+	err := executeNativeAction(stateDB, contract, cronosevents.IcaConvertEvent, func(ctx sdk.Context) error {
+		relativeTimeout, err := timeout(ctx)
+		if err != nil {
+			return err
+		}
+		msgServer := icacontrollerkeeper.NewMsgServerImpl(&ic.controllerKeeper)
+		response, err := msgServer.SendTx(
+			ctx, &icacontrollertypes.MsgSendTx{
+				Owner:           owner,
+				ConnectionId:    connectionID,
+				RelativeTimeout: relativeTimeout,
+				PacketData:      icaMsgData,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		seq = response.Sequence
+
+		// fetch src channel id for event
+		portID, err := icatypes.NewControllerPortID(owner)
+		if err != nil {
 			return err
+		}
+		activeChannelID, found := ic.controllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+		if !found {
+			return errors.New("failed to retrieve active channel")
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				cronoseventstypes.EventTypeSubmitMsgsResult,
+				sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, activeChannelID),
+				sdk.NewAttribute(cronoseventstypes.AttributeKeySeq, fmt.Sprintf("%d", response.Sequence)),
+			),
 		})
-		if execErr != nil {
-			return nil, execErr
+		return nil
+	})
+	return seq, err
+}
+
+func relativeTimeout(timeout *big.Int) func(ctx sdk.Context) (uint64, error) {
+	return func(_ sdk.Context) (uint64, error) {
+		if !timeout.IsUint64() {
+			return 0, errors.New("invalid timeout")
 		}
-		return method.Outputs.Pack(seq)
-	default:
-		return nil, errors.New("unknown method")
+		return timeout.Uint64(), nil
+	}
+}
+
+// absoluteTimeout converts the timeout timestamp in nanoseconds to the timeout relative to the block time.
+func absoluteTimeout(timeoutTimestamp uint64) func(ctx sdk.Context) (uint64, error) {
+	return func(ctx sdk.Context) (uint64, error) {
+		blockTime := uint64(ctx.BlockTime().UnixNano())
+		if timeoutTimestamp <= blockTime {
+			return 0, fmt.Errorf("timeout timestamp is not after the block time: %d <= %d", timeoutTimestamp, blockTime)
+		}
+		return timeoutTimestamp - blockTime, nil
 	}
 }
@@ -0,0 +1,71 @@
+package precompiles_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crypto-org-chain/cronos/v2/app"
+)
+
+// the gas config passed to the precompiled contracts by the app, it only affects the input size cost.
+var gasConfig = storetypes.TransientGasConfig()
+
+func setupTest(t *testing.T) (*app.App, sdk.Context) {
+	t.Helper()
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	a := app.Setup(t, sdk.AccAddress(priv.PubKey().Address()).String())
+	ctx := a.NewContext(false).WithBlockHeight(1)
+	return a, ctx
+}
+
+func newAccount(t *testing.T) common.Address {
+	t.Helper()
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	return common.BytesToAddress(priv.PubKey().Address())
+}
+
+func fundAccount(t *testing.T, a *app.App, ctx sdk.Context, addr common.Address, amount int64) sdk.Coin {
+	t.Helper()
+	bondDenom, err := a.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coin := sdk.NewCoin(bondDenom, sdkmath.NewInt(amount))
+	require.NoError(t, app.FundAccount(a.BankKeeper, ctx, sdk.AccAddress(addr.Bytes()), sdk.NewCoins(coin)))
+	return coin
+}
+
+func newEVM(a *app.App, ctx sdk.Context) (*vm.EVM, *statedb.StateDB) {
+	stateDB := statedb.New(ctx, a.EvmKeeper, statedb.NewEmptyTxConfig(common.Hash{}))
+	return &vm.EVM{StateDB: stateDB}, stateDB
+}
+
+// runPrecompile runs the precompiled contract the same way as the evm, the floor returned by `RequiredGas` is charged
+// before `Run`, returns the output and the gas used.
+func runPrecompile(
+	evm *vm.EVM, p vm.PrecompiledContract, caller common.Address, input []byte, gas uint64, readonly bool,
+) ([]byte, uint64, error) {
+	ret, left, err := evm.RunPrecompiledContract(p, vm.AccountRef(caller), input, gas, big.NewInt(0), readonly)
+	return ret, gas - left, err
+}
+
+// nativeGasUsed runs the native action with the kv gas configs of the native transactions in a branch of the context,
+// returns the gas consumed, which is expected to be charged on top of the floor by the precompiled contracts.
+func nativeGasUsed(t *testing.T, ctx sdk.Context, action func(ctx sdk.Context) error) uint64 {
+	t.Helper()
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithKVGasConfig(storetypes.KVGasConfig()).
+		WithTransientKVGasConfig(storetypes.TransientGasConfig())
+	require.NoError(t, action(cacheCtx))
+	return cacheCtx.GasMeter().GasConsumed()
+}
@@ -15,8 +15,6 @@ import (
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
-	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	cronosevents "github.com/crypto-org-chain/cronos/v2/x/cronos/events"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/relayer"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
@@ -49,56 +47,31 @@ const (
 	Timeout               = "timeout"
 	TimeoutOnClose        = "timeoutOnClose"
 	// ibc fee
-	RegisterPayee                   = "registerPayee"
-	RegisterCounterpartyPayee       = "registerCounterpartyPayee"
-	GasWhenReceiverChainIsSource    = 51705
-	GasWhenReceiverChainIsNotSource = 144025
+	RegisterPayee             = "registerPayee"
+	RegisterCounterpartyPayee = "registerCounterpartyPayee"
 )
 
 func init() {
 	if err := irelayerABI.UnmarshalJSON([]byte(relayer.RelayerFunctionsMetaData.ABI)); err != nil {
 		panic(err)
 	}
+	// the floors cover the verification of the headers and proofs, the state accesses are metered on top of them.
 	for methodName := range irelayerABI.Methods {
 		var methodID [4]byte
 		copy(methodID[:], irelayerABI.Methods[methodName].ID[:4])
 		switch methodName {
-		case CreateClient:
-			relayerGasRequiredByMethod[methodID] = 117462
-		case UpdateClient:
-			relayerGasRequiredByMethod[methodID] = 111894
+		case CreateClient, UpdateClient:
+			relayerGasRequiredByMethod[methodID] = 20000
 		case UpgradeClient:
-			relayerGasRequiredByMethod[methodID] = 400000
-		case ConnectionOpenInit:
-			relayerGasRequiredByMethod[methodID] = 19755
-		case ConnectionOpenTry:
-			relayerGasRequiredByMethod[methodID] = 38468
-		case ConnectionOpenAck:
-			relayerGasRequiredByMethod[methodID] = 29603
-		case ConnectionOpenConfirm:
-			relayerGasRequiredByMethod[methodID] = 12865
-		case ChannelOpenInit:
-			relayerGasRequiredByMethod[methodID] = 68701
-		case ChannelOpenTry:
-			relayerGasRequiredByMethod[methodID] = 70562
-		case ChannelOpenAck:
-			relayerGasRequiredByMethod[methodID] = 22127
-		case ChannelOpenConfirm:
-			relayerGasRequiredByMethod[methodID] = 21190
-		case ChannelCloseConfirm:
-			relayerGasRequiredByMethod[methodID] = 31199
-		case RecvPacket:
-			relayerGasRequiredByMethod[methodID] = GasWhenReceiverChainIsNotSource
-		case Acknowledgement:
-			relayerGasRequiredByMethod[methodID] = 61781
-		case Timeout:
-			relayerGasRequiredByMethod[methodID] = 104283
-		case RegisterPayee:
-			relayerGasRequiredByMethod[methodID] = 38000
-		case RegisterCounterpartyPayee:
-			relayerGasRequiredByMethod[methodID] = 37000
+			relayerGasRequiredByMethod[methodID] = 40000
+		case ConnectionOpenTry, ConnectionOpenAck, ConnectionOpenConfirm,
+			ChannelOpenTry, ChannelOpenAck, ChannelOpenConfirm, ChannelCloseConfirm,
+			RecvPacket, Acknowledgement, Timeout, TimeoutOnClose:
+			relayerGasRequiredByMethod[methodID] = 10000
+		case ConnectionOpenInit, ChannelOpenInit, ChannelCloseInit, RegisterPayee, RegisterCounterpartyPayee:
+			relayerGasRequiredByMethod[methodID] = 2000
 		default:
-			relayerGasRequiredByMethod[methodID] = 100000
+			relayerGasRequiredByMethod[methodID] = 10000
 		}
 		relayerMethodNamedByMethod[methodID] = methodName
 	}
@@ -133,37 +106,16 @@ func (bc *RelayerContract) Address() common.Address {
 	return relayerContractAddress
 }
 
-// RequiredGas calculates the contract gas use
-// `max(0, len(input) * DefaultTxSizeCostPerByte + requiredGasTable[methodPrefix] - intrinsicGas)`
+// RequiredGas calculates the floor of the contract gas use
+// `max(0, len(input) * DefaultTxSizeCostPerByte + requiredGasTable[methodPrefix] - intrinsicGas)`,
+// the gas consumed by the state accesses is charged in `Run`.
 func (bc *RelayerContract) RequiredGas(input []byte) (gas uint64) {
 	// base cost to prevent large input size
 	inputLen := len(input)
 	baseCost := uint64(inputLen) * authtypes.DefaultTxSizeCostPerByte
 	var methodID [4]byte
-	copy(methodID[:], input[:4])
+	copy(methodID[:], input)
 	requiredGas, ok := relayerGasRequiredByMethod[methodID]
-	method, err := irelayerABI.MethodById(methodID[:])
-	if err != nil {
-		panic(err)
-	}
-	if method.Name == RecvPacket {
-		args, err := method.Inputs.Unpack(input[4:])
-		if err != nil {
-			panic(err)
-		}
-		i := args[0].([]byte)
-		var msg channeltypes.MsgRecvPacket
-		if err = bc.cdc.Unmarshal(i, &msg); err != nil {
-			panic(err)
-		}
-		var data ibctransfertypes.FungibleTokenPacketData
-		if err = ibctransfertypes.ModuleCdc.UnmarshalJSON(msg.Packet.GetData(), &data); err != nil {
-			panic(err)
-		}
-		if ibctransfertypes.ReceiverChainIsSource(msg.Packet.GetSourcePort(), msg.Packet.GetSourceChannel(), data.Denom) {
-			requiredGas = GasWhenReceiverChainIsSource
-		}
-	}
 	intrinsicGas, _ := core.IntrinsicGas(input, nil, false, bc.isHomestead, bc.isIstanbul, bc.isShanghai)
 	defer func() {
 		methodName := relayerMethodNamedByMethod[methodID]
@@ -194,14 +146,13 @@ func (bc *RelayerContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool
 	}
 	stateDB := evm.StateDB.(ExtStateDB)
 	var res []byte
-	precompileAddr := bc.Address()
 	args, err := method.Inputs.Unpack(contract.Input[4:])
 	if err != nil {
 		return nil, errors.New("fail to unpack input arguments")
 	}
 	converter := cronosevents.RelayerConvertEvent
 	if method.Name == RegisterPayee || method.Name == RegisterCounterpartyPayee {
-		execErr := stateDB.ExecuteNativeAction(precompileAddr, converter, func(ctx sdk.Context) error {
+		execErr := executeNativeAction(stateDB, contract, converter, func(ctx sdk.Context) error {
 			portID := args[0].(string)
 			channelID := args[1].(string)
 			caller := sdk.AccAddress(contract.CallerAddress.Bytes()).String()
@@ -230,8 +181,7 @@ func (bc *RelayerContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool
 	e := &Executor{
 		cdc:       bc.cdc,
 		stateDB:   stateDB,
-		caller:    contract.CallerAddress,
-		contract:  precompileAddr,
+		contract:  contract,
 		input:     input,
 		converter: converter,
 	}
@@ -0,0 +1,146 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cronosevents "github.com/crypto-org-chain/cronos/v2/x/cronos/events"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/staking"
+)
+
+const (
+	DelegateMethodName                  = "delegate"
+	UndelegateMethodName                = "undelegate"
+	RedelegateMethodName                = "redelegate"
+	CancelUnbondingDelegationMethodName = "cancelUnbondingDelegation"
+	WithdrawDelegatorRewardMethodName   = "withdrawDelegatorReward"
+	SetWithdrawAddressMethodName        = "setWithdrawAddress"
+)
+
+var (
+	stakingABI                 abi.ABI
+	stakingContractAddress     = common.BytesToAddress([]byte{103})
+	stakingGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	if err := stakingABI.UnmarshalJSON([]byte(staking.StakingModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range stakingABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], stakingABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case DelegateMethodName:
+			stakingGasRequiredByMethod[methodID] = 20000
+		case UndelegateMethodName, CancelUnbondingDelegationMethodName:
+			stakingGasRequiredByMethod[methodID] = 25000
+		case RedelegateMethodName:
+			stakingGasRequiredByMethod[methodID] = 30000
+		case WithdrawDelegatorRewardMethodName:
+			stakingGasRequiredByMethod[methodID] = 15000
+		case SetWithdrawAddressMethodName:
+			stakingGasRequiredByMethod[methodID] = 5000
+		default:
+			stakingGasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+// StakingContract is the precompiled contract for the EVM contracts to manage their delegations and rewards, the
+// inputs are the protobuf encoded staking and distribution messages, the delegator must be the caller.
+type StakingContract struct {
+	BaseContract
+
+	cdc              codec.Codec
+	stakingMsgServer stakingtypes.MsgServer
+	distrMsgServer   distrtypes.MsgServer
+	kvGasConfig      storetypes.GasConfig
+}
+
+func NewStakingContract(
+	stakingMsgServer stakingtypes.MsgServer,
+	distrMsgServer distrtypes.MsgServer,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) vm.PrecompiledContract {
+	return &StakingContract{
+		BaseContract:     NewBaseContract(stakingContractAddress),
+		cdc:              cdc,
+		stakingMsgServer: stakingMsgServer,
+		distrMsgServer:   distrMsgServer,
+		kvGasConfig:      kvGasConfig,
+	}
+}
+
+func (sc *StakingContract) Address() common.Address {
+	return stakingContractAddress
+}
+
+// RequiredGas calculates the floor of the contract gas use, the gas consumed by the native action is charged in `Run`
+func (sc *StakingContract) RequiredGas(input []byte) uint64 {
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * sc.kvGasConfig.WriteCostPerByte
+	var methodID [4]byte
+	copy(methodID[:], input)
+	requiredGas, ok := stakingGasRequiredByMethod[methodID]
+	if ok {
+		return requiredGas + baseCost
+	}
+	return baseCost
+}
+
+func (sc *StakingContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if readonly {
+		return nil, errors.New("the method is not readonly")
+	}
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
+	// parse input
+	method, err := stakingABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	e := &Executor{
+		cdc:       sc.cdc,
+		stateDB:   evm.StateDB.(ExtStateDB),
+		contract:  contract,
+		input:     args[0].([]byte),
+		converter: cronosevents.StakingConvertEvent,
+	}
+
+	var res []byte
+	switch method.Name {
+	case DelegateMethodName:
+		res, err = exec(e, sc.stakingMsgServer.Delegate)
+	case UndelegateMethodName:
+		res, err = exec(e, sc.stakingMsgServer.Undelegate)
+	case RedelegateMethodName:
+		res, err = exec(e, sc.stakingMsgServer.BeginRedelegate)
+	case CancelUnbondingDelegationMethodName:
+		res, err = exec(e, sc.stakingMsgServer.CancelUnbondingDelegation)
+	case WithdrawDelegatorRewardMethodName:
+		res, err = exec(e, sc.distrMsgServer.WithdrawDelegatorReward)
+	case SetWithdrawAddressMethodName:
+		res, err = exec(e, sc.distrMsgServer.SetWithdrawAddress)
+	default:
+		return nil, fmt.Errorf("unknown method: %s", method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(res)
+}
@@ -0,0 +1,102 @@
+package precompiles_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crypto-org-chain/cronos/v2/app"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/staking"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/precompiles"
+)
+
+func newStakingContract(a *app.App) vm.PrecompiledContract {
+	return precompiles.NewStakingContract(
+		stakingkeeper.NewMsgServerImpl(a.StakingKeeper),
+		distrkeeper.NewMsgServerImpl(a.DistrKeeper),
+		a.AppCodec(),
+		gasConfig,
+	)
+}
+
+func packStakingInput(t *testing.T, a *app.App, method string, msg sdk.Msg) []byte {
+	t.Helper()
+	abi, err := staking.StakingModuleMetaData.GetAbi()
+	require.NoError(t, err)
+	bz, err := a.AppCodec().Marshal(msg)
+	require.NoError(t, err)
+	input, err := abi.Pack(method, bz)
+	require.NoError(t, err)
+	return input
+}
+
+func TestStakingContract(t *testing.T) {
+	a, ctx := setupTest(t)
+	validators, err := a.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	validator := validators[0].OperatorAddress
+
+	caller := newAccount(t)
+	other := newAccount(t)
+	coin := fundAccount(t, a, ctx, caller, 1000)
+	delegator := sdk.AccAddress(caller.Bytes())
+	contract := newStakingContract(a)
+
+	delegate := packStakingInput(t, a, precompiles.DelegateMethodName,
+		stakingtypes.NewMsgDelegate(delegator.String(), validator, coin))
+
+	// the delegator must be the caller
+	evm, _ := newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, other, delegate, contract.RequiredGas(delegate)+1000000, false)
+	require.ErrorContains(t, err, "caller is not authenticated")
+
+	// not allowed in static call
+	_, _, err = runPrecompile(evm, contract, caller, delegate, contract.RequiredGas(delegate)+1000000, true)
+	require.ErrorContains(t, err, "the method is not readonly")
+
+	evm, stateDB := newEVM(a, ctx)
+	ret, _, err := runPrecompile(evm, contract, caller, delegate, contract.RequiredGas(delegate)+1000000, false)
+	require.NoError(t, err)
+	require.NotEmpty(t, ret)
+	require.NoError(t, stateDB.Commit())
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	require.NoError(t, err)
+	delegation, err := a.StakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	require.NoError(t, err)
+	require.True(t, delegation.Shares.IsPositive())
+	require.True(t, a.BankKeeper.GetBalance(ctx, delegator, coin.Denom).IsZero())
+
+	// the distribution messages are authenticated the same way
+	setWithdrawAddress := packStakingInput(t, a, precompiles.SetWithdrawAddressMethodName,
+		distrtypes.NewMsgSetWithdrawAddress(delegator, sdk.AccAddress(other.Bytes())))
+	evm, _ = newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, other, setWithdrawAddress, contract.RequiredGas(setWithdrawAddress)+1000000, false)
+	require.ErrorContains(t, err, "caller is not authenticated")
+
+	evm, stateDB = newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, caller, setWithdrawAddress, contract.RequiredGas(setWithdrawAddress)+1000000, false)
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Commit())
+	withdrawAddr, err := a.DistrKeeper.GetDelegatorWithdrawAddr(ctx, delegator)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(other.Bytes()), withdrawAddr)
+
+	// undelegate the shares
+	undelegate := packStakingInput(t, a, precompiles.UndelegateMethodName,
+		stakingtypes.NewMsgUndelegate(delegator.String(), validator, sdk.NewCoin(coin.Denom, sdkmath.NewInt(500))))
+	evm, _ = newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, other, undelegate, contract.RequiredGas(undelegate)+1000000, false)
+	require.ErrorContains(t, err, "caller is not authenticated")
+	evm, _ = newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, caller, undelegate, contract.RequiredGas(undelegate)+1000000, false)
+	require.NoError(t, err)
+}
@@ -0,0 +1,163 @@
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	cronosevents "github.com/crypto-org-chain/cronos/v2/x/cronos/events"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/transfer"
+	cronoseventstypes "github.com/crypto-org-chain/cronos/v2/x/cronos/events/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	IbcTransferMethodName = "transfer"
+)
+
+var (
+	transferABI                 abi.ABI
+	transferContractAddress     = common.BytesToAddress([]byte{104})
+	transferGasRequiredByMethod = map[[4]byte]uint64{}
+)
+
+func init() {
+	if err := transferABI.UnmarshalJSON([]byte(transfer.TransferModuleMetaData.ABI)); err != nil {
+		panic(err)
+	}
+	for methodName := range transferABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], transferABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case IbcTransferMethodName:
+			transferGasRequiredByMethod[methodID] = 20000
+		default:
+			transferGasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+// TransferContract is the precompiled contract for the EVM contracts to send ICS-20 transfers synchronously,
+// the caller is the sender of the transfer, and the result of the packet is delivered back to the caller through
+// `onPacketResultCallback` once it's acknowledged or timed out.
+type TransferContract struct {
+	BaseContract
+
+	ctx          sdk.Context
+	cronosKeeper types.CronosKeeper
+	kvGasConfig  storetypes.GasConfig
+}
+
+func NewTransferContract(
+	ctx sdk.Context,
+	cronosKeeper types.CronosKeeper,
+	kvGasConfig storetypes.GasConfig,
+) vm.PrecompiledContract {
+	return &TransferContract{
+		BaseContract: NewBaseContract(transferContractAddress),
+		ctx:          ctx,
+		cronosKeeper: cronosKeeper,
+		kvGasConfig:  kvGasConfig,
+	}
+}
+
+func (tc *TransferContract) Address() common.Address {
+	return transferContractAddress
+}
+
+// RequiredGas calculates the floor of the contract gas use, the gas of the callback is charged upfront,
+// the gas consumed by the native action is charged in `Run`
+func (tc *TransferContract) RequiredGas(input []byte) uint64 {
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * tc.kvGasConfig.WriteCostPerByte
+	var methodID [4]byte
+	copy(methodID[:], input)
+	requiredGas, ok := transferGasRequiredByMethod[methodID]
+	if ok {
+		return requiredGas + tc.cronosKeeper.GetParams(tc.ctx).MaxCallbackGas + baseCost
+	}
+	return baseCost
+}
+
+func (tc *TransferContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if readonly {
+		return nil, errors.New("the method is not readonly")
+	}
+	if len(contract.Input) < 4 {
+		return nil, errors.New("input too short")
+	}
+	// parse input
+	method, err := transferABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name != IbcTransferMethodName {
+		return nil, fmt.Errorf("unknown method: %s", method.Name)
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, errors.New("fail to unpack input arguments")
+	}
+	channelID := args[0].(string)
+	receiver := args[1].(string)
+	denom := args[2].(string)
+	amount := args[3].(*big.Int)
+	timeout := args[4].(*big.Int)
+	if amount.Sign() <= 0 || amount.BitLen() > sdkmath.MaxBitLen {
+		return nil, errors.New("invalid amount")
+	}
+	if timeout.Sign() <= 0 || !timeout.IsInt64() {
+		return nil, errors.New("invalid timeout")
+	}
+
+	stateDB := evm.StateDB.(ExtStateDB)
+	caller := contract.CallerAddress
+	seq := uint64(0)
+	err = executeNativeAction(stateDB, contract, cronosevents.TransferConvertEvent, func(ctx sdk.Context) error {
+		msg := &ibctransfertypes.MsgTransfer{
+			SourcePort:       ibctransfertypes.PortID,
+			SourceChannel:    channelID,
+			Token:            sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)},
+			Sender:           sdk.AccAddress(caller.Bytes()).String(),
+			Receiver:         receiver,
+			TimeoutHeight:    clienttypes.ZeroHeight(),
+			TimeoutTimestamp: uint64(ctx.BlockTime().Add(time.Duration(timeout.Int64())).UnixNano()),
+			Memo:             fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, caller.String()),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		// the bridge and rate limit checks are applied as the other outgoing transfers
+		var err error
+		seq, err = tc.cronosKeeper.SendTransfer(
+			ctx, sdk.AccAddress(caller.Bytes()), msg.Receiver, msg.Token, msg.SourceChannel,
+			msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+		)
+		if err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				cronoseventstypes.EventTypeTransferResult,
+				sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, channelID),
+				sdk.NewAttribute(cronoseventstypes.AttributeKeySeq, fmt.Sprintf("%d", seq)),
+			),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(seq)
+}
@@ -5,13 +5,22 @@ import (
 	"errors"
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/x/evm/statedb"
 )
 
+var (
+	// the gas configs to meter the native state accesses of the precompiled contracts, the kv gas configs are zeroed
+	// for the ethereum transactions in the ante handler, so the same costs as the native transactions are applied.
+	nativeKVGasConfig          = storetypes.KVGasConfig()
+	nativeTransientKVGasConfig = storetypes.TransientGasConfig()
+)
+
 type NativeMessage interface {
 	proto.Message
 	GetSigners() []sdk.AccAddress
@@ -20,16 +29,30 @@ type NativeMessage interface {
 type Executor struct {
 	cdc       codec.Codec
 	stateDB   ExtStateDB
-	caller    common.Address
-	contract  common.Address
+	contract  *vm.Contract
 	input     []byte
 	converter statedb.EventConverter
 }
 
+// getSigners returns the signers of the message, the legacy `GetSigners` is preferred if implemented, otherwise
+// they are resolved from the `cosmos.msg.v1.signer` option of the message.
+func getSigners(cdc codec.Codec, msg proto.Message) ([][]byte, error) {
+	if m, ok := msg.(NativeMessage); ok {
+		signers := m.GetSigners()
+		out := make([][]byte, len(signers))
+		for i, signer := range signers {
+			out[i] = signer
+		}
+		return out, nil
+	}
+	signers, _, err := cdc.GetMsgV1Signers(msg)
+	return signers, err
+}
+
 // exec is a generic function that executes the given action in statedb, and marshal/unmarshal the input/output
 func exec[Req any, PReq interface {
 	*Req
-	NativeMessage
+	proto.Message
 }, Resp proto.Message](
 	e *Executor,
 	action func(context.Context, PReq) (Resp, error),
@@ -39,17 +62,20 @@ func exec[Req any, PReq interface {
 		return nil, fmt.Errorf("fail to Unmarshal %T %w", msg, err)
 	}
 
-	signers := msg.GetSigners()
+	signers, err := getSigners(e.cdc, msg)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get signers of %T %w", msg, err)
+	}
 	if len(signers) != 1 {
 		return nil, errors.New("don't support multi-signers message")
 	}
-	caller := common.BytesToAddress(signers[0].Bytes())
-	if caller != e.caller {
-		return nil, fmt.Errorf("caller is not authenticated: expected %s, got %s", e.caller.Hex(), caller.Hex())
+	caller := common.BytesToAddress(signers[0])
+	if caller != e.contract.CallerAddress {
+		return nil, fmt.Errorf("caller is not authenticated: expected %s, got %s", e.contract.CallerAddress.Hex(), caller.Hex())
 	}
 
 	var res Resp
-	if err := e.stateDB.ExecuteNativeAction(e.contract, e.converter, func(ctx sdk.Context) error {
+	if err := executeNativeAction(e.stateDB, e.contract, e.converter, func(ctx sdk.Context) error {
 		var err error
 		res, err = action(ctx, msg)
 		return err
@@ -63,3 +89,48 @@ func exec[Req any, PReq interface {
 	}
 	return output, nil
 }
+
+// withGasMeter runs fn with a gas meter limited by the gas left in the contract, the gas consumed by the native state
+// accesses is charged to the contract on top of the floor of the method charged in `RequiredGas`.
+func withGasMeter(ctx sdk.Context, contract *vm.Contract, fn func(ctx sdk.Context) error) (err error) {
+	gasMeter := storetypes.NewGasMeter(contract.Gas)
+	defer func() {
+		if r := recover(); r != nil {
+			switch r.(type) {
+			case storetypes.ErrorOutOfGas, storetypes.ErrorGasOverflow:
+				err = vm.ErrOutOfGas
+			default:
+				panic(r)
+			}
+		}
+	}()
+
+	ctx = ctx.WithGasMeter(gasMeter).
+		WithKVGasConfig(nativeKVGasConfig).
+		WithTransientKVGasConfig(nativeTransientKVGasConfig)
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	if !contract.UseGas(gasMeter.GasConsumed()) {
+		return vm.ErrOutOfGas
+	}
+	return nil
+}
+
+// executeNativeAction executes the action in statedb with the gas consumed charged to the contract, see `withGasMeter`.
+func executeNativeAction(
+	stateDB ExtStateDB,
+	contract *vm.Contract,
+	converter statedb.EventConverter,
+	action func(ctx sdk.Context) error,
+) error {
+	return stateDB.ExecuteNativeAction(contract.Address(), converter, func(ctx sdk.Context) error {
+		return withGasMeter(ctx, contract, action)
+	})
+}
+
+// query runs the read-only fn against the current native state with the gas consumed charged to the contract,
+// see `withGasMeter`.
+func query(stateDB ExtStateDB, contract *vm.Contract, fn func(ctx sdk.Context) error) error {
+	return withGasMeter(stateDB.Context(), contract, fn)
+}
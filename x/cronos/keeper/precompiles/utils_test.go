@@ -0,0 +1,90 @@
+package precompiles_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/events/bindings/cosmos/precompile/gov"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/precompiles"
+)
+
+func TestNativeActionGas(t *testing.T) {
+	a, ctx := setupTest(t)
+	validators, err := a.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+
+	caller := newAccount(t)
+	coin := fundAccount(t, a, ctx, caller, 1000)
+	msg := stakingtypes.NewMsgDelegate(sdk.AccAddress(caller.Bytes()).String(), validators[0].OperatorAddress, coin)
+	contract := newStakingContract(a)
+	input := packStakingInput(t, a, precompiles.DelegateMethodName, msg)
+
+	nativeGas := nativeGasUsed(t, ctx, func(ctx sdk.Context) error {
+		_, err := stakingkeeper.NewMsgServerImpl(a.StakingKeeper).Delegate(ctx, msg)
+		return err
+	})
+	require.NotZero(t, nativeGas)
+	floor := contract.RequiredGas(input)
+
+	// the floor and the gas consumed by the native action are charged
+	evm, _ := newEVM(a, ctx)
+	_, gasUsed, err := runPrecompile(evm, contract, caller, input, floor+nativeGas+1000, false)
+	require.NoError(t, err)
+	require.Equal(t, floor+nativeGas, gasUsed)
+
+	// exactly enough gas
+	evm, _ = newEVM(a, ctx)
+	_, gasUsed, err = runPrecompile(evm, contract, caller, input, floor+nativeGas, false)
+	require.NoError(t, err)
+	require.Equal(t, floor+nativeGas, gasUsed)
+
+	// the out of gas panic of the native gas meter is returned as the evm error, the native state is reverted
+	evm, stateDB := newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, caller, input, floor+nativeGas-1, false)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+	require.NoError(t, stateDB.Commit())
+	require.Equal(t, coin, a.BankKeeper.GetBalance(ctx, sdk.AccAddress(caller.Bytes()), coin.Denom))
+
+	// not enough gas for the floor
+	evm, _ = newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, caller, input, floor-1, false)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+}
+
+func TestQueryGas(t *testing.T) {
+	a, ctx := setupTest(t)
+	abi, err := gov.GovModuleMetaData.GetAbi()
+	require.NoError(t, err)
+
+	caller := newAccount(t)
+	proposal, err := a.GovKeeper.SubmitProposal(ctx, nil, "", "title", "summary", sdk.AccAddress(caller.Bytes()), false)
+	require.NoError(t, err)
+
+	contract := newGovContract(a)
+	input, err := abi.Pack(precompiles.GetProposalMethodName, proposal.Id)
+	require.NoError(t, err)
+
+	nativeGas := nativeGasUsed(t, ctx, func(ctx sdk.Context) error {
+		_, err := govkeeper.NewQueryServer(&a.GovKeeper).Proposal(ctx, &govv1.QueryProposalRequest{ProposalId: proposal.Id})
+		return err
+	})
+	require.NotZero(t, nativeGas)
+	floor := contract.RequiredGas(input)
+
+	evm, _ := newEVM(a, ctx)
+	_, gasUsed, err := runPrecompile(evm, contract, caller, input, floor+nativeGas+1000, true)
+	require.NoError(t, err)
+	require.Equal(t, floor+nativeGas, gasUsed)
+
+	evm, _ = newEVM(a, ctx)
+	_, _, err = runPrecompile(evm, contract, caller, input, floor+nativeGas-1, true)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// SetRateLimit creates or updates a rate limit, the usage of it is reset.
+func (k Keeper) SetRateLimit(ctx sdk.Context, limit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RateLimitKey(limit.Denom, limit.ChannelId), k.cdc.MustMarshal(&limit))
+	store.Delete(types.RateLimitUsageKey(limit.Denom, limit.ChannelId))
+}
+
+// GetRateLimit returns the rate limit of the denom through the channel, empty channel id means all the channels.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RateLimitKey(denom, channelID))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+	var limit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+	return limit, true
+}
+
+// RemoveRateLimit removes the rate limit and the usage of it, returns false if not found.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RateLimitKey(denom, channelID)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	store.Delete(types.RateLimitUsageKey(denom, channelID))
+	return true
+}
+
+// GetRateLimits returns all the rate limits
+func (k Keeper) GetRateLimits(ctx sdk.Context) (out []types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var limit types.RateLimit
+		k.cdc.MustUnmarshal(iter.Value(), &limit)
+		out = append(out, limit)
+	}
+	return out
+}
+
+// GetRateLimitUsage returns the usage of the rate limit in the current window,
+// a new window is started if the previous one is expired.
+func (k Keeper) GetRateLimitUsage(ctx sdk.Context, limit types.RateLimit) (types.RateLimitUsage, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RateLimitUsageKey(limit.Denom, limit.ChannelId))
+	if bz != nil {
+		var usage types.RateLimitUsage
+		k.cdc.MustUnmarshal(bz, &usage)
+		if ctx.BlockTime().Before(usage.WindowStart.Add(limit.Window)) {
+			return usage, nil
+		}
+	}
+
+	supply, err := k.GetRateLimitSupply(ctx, limit.Denom)
+	if err != nil {
+		return types.RateLimitUsage{}, err
+	}
+	return types.NewRateLimitUsage(limit.Denom, limit.ChannelId, supply, ctx.BlockTime()), nil
+}
+
+func (k Keeper) setRateLimitUsage(ctx sdk.Context, usage types.RateLimitUsage) {
+	ctx.KVStore(k.storeKey).Set(types.RateLimitUsageKey(usage.Denom, usage.ChannelId), k.cdc.MustMarshal(&usage))
+}
+
+// GetRateLimitSupply returns the total supply of the denom on chain, which is the base of the percentage quotas.
+// The supply of the source tokens is the total supply of the mapped CRC21 contract, since the native coins are
+// backed by the CRC21 tokens locked in the contract, for the ibc vouchers, the CRC21 tokens are backed by the native
+// coins, so it's the bank supply.
+func (k Keeper) GetRateLimitSupply(ctx sdk.Context, denom string) (sdkmath.Int, error) {
+	if types.IsSourceCoin(denom) {
+		if contract, found := k.GetContractByDenom(ctx, denom); found {
+			res, err := k.CallModuleCRC21(ctx, contract, "totalSupply")
+			if err != nil {
+				return sdkmath.Int{}, err
+			}
+			return sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(res)), nil
+		}
+	}
+	return k.bankKeeper.GetSupply(ctx, denom).Amount, nil
+}
+
+// getMatchingRateLimits returns the rate limits apply to the flow of the denom through the channel.
+func (k Keeper) getMatchingRateLimits(ctx sdk.Context, denom, channelID string) (out []types.RateLimit) {
+	if limit, found := k.GetRateLimit(ctx, denom, channelID); found {
+		out = append(out, limit)
+	}
+	if channelID != "" {
+		if limit, found := k.GetRateLimit(ctx, denom, ""); found {
+			out = append(out, limit)
+		}
+	}
+	return out
+}
+
+// CheckAndUpdateRateLimits accounts the ibc transfer flow to the matching rate limits,
+// returns error if any of the quotas are exceeded, returns if the flow is accounted by any rate limits.
+func (k Keeper) CheckAndUpdateRateLimits(
+	ctx sdk.Context, direction types.BridgeDirection, denom, channelID string, amount sdkmath.Int,
+) (bool, error) {
+	limits := k.getMatchingRateLimits(ctx, denom, channelID)
+	for _, limit := range limits {
+		usage, err := k.GetRateLimitUsage(ctx, limit)
+		if err != nil {
+			return false, err
+		}
+		usage.AddFlow(direction, amount)
+		if quota, found := limit.Quota(direction, usage.Supply); found && usage.Flow(direction).GT(quota) {
+			return false, errors.Wrapf(
+				types.ErrRateLimitExceeded, "direction: %s, denom: %s, channel: %s, quota: %s, flow: %s",
+				direction, limit.Denom, limit.ChannelId, quota, usage.Flow(direction),
+			)
+		}
+		k.setRateLimitUsage(ctx, usage)
+	}
+	return len(limits) > 0, nil
+}
+
+// setRateLimitPendingPacket records an outgoing packet accounted by rate limits,
+// so the outflow can be reverted if the tokens are refunded.
+func (k Keeper) setRateLimitPendingPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.RateLimitPendingPacketKey(channelID, sequence), []byte{1})
+}
+
+// DeleteRateLimitPendingPacket removes the record of an outgoing packet, returns false if not found.
+func (k Keeper) DeleteRateLimitPendingPacket(ctx sdk.Context, channelID string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RateLimitPendingPacketKey(channelID, sequence)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	return true
+}
+
+// RevertRateLimitOutflow reverts the outflow of a refunded packet accounted by rate limits, the outflow is floored at
+// zero in case a new window is started.
+func (k Keeper) RevertRateLimitOutflow(ctx sdk.Context, channelID string, sequence uint64, denom string, amount sdkmath.Int) error {
+	if !k.DeleteRateLimitPendingPacket(ctx, channelID, sequence) {
+		return nil
+	}
+	for _, limit := range k.getMatchingRateLimits(ctx, denom, channelID) {
+		usage, err := k.GetRateLimitUsage(ctx, limit)
+		if err != nil {
+			return err
+		}
+		usage.AddFlow(types.BridgeDirectionOutbound, amount.Neg())
+		k.setRateLimitUsage(ctx, usage)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	keepertest "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper/mock"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+func newTestRateLimit(denom, channelID string, maxPercent, maxAmount int64) types.RateLimit {
+	return types.RateLimit{
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: sdkmath.NewInt(maxPercent),
+		MaxPercentRecv: sdkmath.NewInt(maxPercent),
+		MaxAmountSend:  sdkmath.NewInt(maxAmount),
+		MaxAmountRecv:  sdkmath.NewInt(maxAmount),
+		Window:         time.Hour,
+	}
+}
+
+func (suite *KeeperTestSuite) TestRateLimitTransfer() {
+	suite.SetupTest()
+	// Create Cronos Keeper with mock transfer keeper
+	suite.app.CronosKeeper = *cronosmodulekeeper.NewKeeper(
+		suite.app.EncodingConfig().Codec,
+		suite.app.GetKey(types.StoreKey),
+		suite.app.GetKey(types.MemStoreKey),
+		suite.app.BankKeeper,
+		keepertest.IbcKeeperMock{},
+		suite.app.EvmKeeper,
+		suite.app.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	keeper := suite.app.CronosKeeper
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	suite.Require().NoError(suite.MintCoins(address, sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(1000)))))
+	keeper.SetAutoContractForDenom(suite.ctx, CorrectIbcDenom, common.HexToAddress("0x11"))
+
+	transfer := func(amount int64) error {
+		return keeper.IbcTransferCoins(
+			suite.ctx, address.String(), "to", sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, sdkmath.NewInt(amount))), "",
+		)
+	}
+
+	// 20% of supply 1000 and absolute cap 300, the lower one is chosen.
+	keeper.SetRateLimit(suite.ctx, newTestRateLimit(CorrectIbcDenom, "channel-0", 20, 300))
+	// the limit of other channels don't apply.
+	keeper.SetRateLimit(suite.ctx, newTestRateLimit(CorrectIbcDenom, "channel-1", 0, 1))
+
+	suite.Require().NoError(transfer(150))
+	suite.Require().ErrorIs(transfer(60), types.ErrRateLimitExceeded)
+	suite.Require().NoError(transfer(50))
+
+	rsp, err := keeper.RateLimits(suite.ctx, &types.QueryRateLimitsRequest{ChannelId: "channel-0"})
+	suite.Require().NoError(err)
+	suite.Require().Len(rsp.RateLimits, 1)
+	usage := rsp.RateLimits[0].Usage
+	suite.Require().Equal(sdkmath.NewInt(200), usage.Outflow)
+	suite.Require().Equal(sdkmath.ZeroInt(), usage.Inflow)
+	suite.Require().Equal(sdkmath.NewInt(1000), usage.Supply)
+
+	// the inflow is accounted separately
+	limited, err := keeper.CheckAndUpdateRateLimits(suite.ctx, types.BridgeDirectionInbound, CorrectIbcDenom, "channel-0", sdkmath.NewInt(200))
+	suite.Require().NoError(err)
+	suite.Require().True(limited)
+	limited, err = keeper.CheckAndUpdateRateLimits(suite.ctx, types.BridgeDirectionInbound, CorrectCronosDenom, "channel-0", sdkmath.NewInt(200))
+	suite.Require().NoError(err)
+	suite.Require().False(limited)
+
+	// the aggregated limit of all channels applies too
+	keeper.SetRateLimit(suite.ctx, newTestRateLimit(CorrectIbcDenom, "", 0, 100))
+	suite.Require().ErrorIs(transfer(101), types.ErrRateLimitExceeded)
+
+	// the packets not accounted are not reverted
+	suite.Require().NoError(keeper.RevertRateLimitOutflow(suite.ctx, "channel-0", 1, CorrectIbcDenom, sdkmath.NewInt(200)))
+	suite.Require().ErrorIs(transfer(1), types.ErrRateLimitExceeded)
+
+	// the usage is reset in a new window
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(transfer(100))
+	rsp, err = keeper.RateLimits(suite.ctx, &types.QueryRateLimitsRequest{Denom: CorrectIbcDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(rsp.RateLimits, 3)
+	for _, status := range rsp.RateLimits {
+		if status.RateLimit.ChannelId == "channel-1" {
+			suite.Require().Equal(sdkmath.ZeroInt(), status.Usage.Outflow)
+		} else {
+			suite.Require().Equal(sdkmath.NewInt(100), status.Usage.Outflow)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetRateLimit() {
+	suite.SetupTest()
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(suite.app.CronosKeeper)
+	authority := suite.app.CronosKeeper.GetAuthority()
+	admin := sdk.AccAddress(suite.address.Bytes())
+	limit := newTestRateLimit(CorrectIbcDenom, "channel-0", 10, 0)
+
+	// only governance is authorized
+	_, err := msgServer.SetRateLimit(suite.ctx, types.NewMsgSetRateLimit(admin.String(), limit))
+	suite.Require().Error(err)
+
+	_, err = msgServer.SetRateLimit(suite.ctx, types.NewMsgSetRateLimit(authority, limit))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{limit}, suite.app.CronosKeeper.GetRateLimits(suite.ctx))
+
+	_, err = msgServer.RemoveRateLimit(suite.ctx, types.NewMsgRemoveRateLimit(authority, CorrectIbcDenom, ""))
+	suite.Require().Error(err)
+	_, err = msgServer.RemoveRateLimit(suite.ctx, types.NewMsgRemoveRateLimit(authority, CorrectIbcDenom, "channel-0"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.CronosKeeper.GetRateLimits(suite.ctx))
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// MigrateTokenContract migrates the denom to the external contract, or back to the auto-deployed contract if the
+// contract is empty, returns the contract migrated to.
+// The old contract is paused and disconnected from the native token, the balances in it are migrated by
+// `MigrateTokenBalances` afterwards, so an external contract must be pausable by the module to be migrated from.
+func (k Keeper) MigrateTokenContract(ctx sdk.Context, denom, contract string) (common.Address, error) {
+	if types.IsSourceCoin(denom) {
+		return common.Address{}, fmt.Errorf("the contract of source token can't be migrated: %s", denom)
+	}
+	oldContract, found := k.GetContractByDenom(ctx, denom)
+	if !found {
+		return common.Address{}, fmt.Errorf("no contract found for the denom %s", denom)
+	}
+	autoContract, hasAuto := k.getAutoContractByDenom(ctx, denom)
+
+	var newContract common.Address
+	if len(contract) == 0 {
+		if hasAuto && oldContract == autoContract {
+			return common.Address{}, fmt.Errorf("the denom %s is already mapped to the auto-deployed contract", denom)
+		}
+		if err := k.pauseContract(ctx, oldContract); err != nil {
+			return common.Address{}, err
+		}
+		k.DeleteExternalContractForDenom(ctx, denom)
+		if hasAuto {
+			// resume the auto-deployed contract paused in previous migration
+			if _, err := k.CallModuleCRC21(ctx, autoContract, "start"); err != nil {
+				return common.Address{}, err
+			}
+			newContract = autoContract
+		} else {
+			var err error
+			newContract, err = k.DeployModuleCRC21(ctx, denom)
+			if err != nil {
+				return common.Address{}, err
+			}
+		}
+		k.SetAutoContractForDenom(ctx, denom, newContract)
+	} else {
+		newContract = common.HexToAddress(contract)
+		if hasAuto && newContract == autoContract {
+			return common.Address{}, fmt.Errorf("migrate to the auto-deployed contract with an empty contract address")
+		}
+		if newContract == oldContract {
+			return common.Address{}, fmt.Errorf("the denom %s is already mapped to the contract %s", denom, contract)
+		}
+		if err := k.SetExternalContractForDenom(ctx, denom, newContract); err != nil {
+			return common.Address{}, err
+		}
+		if err := k.pauseContract(ctx, oldContract); err != nil {
+			return common.Address{}, err
+		}
+		if _, found := k.GetMigratedContractDenom(ctx, newContract); found {
+			// resume the external contract paused in previous migration
+			if _, err := k.CallModuleCRC21(ctx, newContract, "start"); err != nil {
+				return common.Address{}, err
+			}
+		}
+	}
+
+	k.SetMigratedContract(ctx, denom, oldContract)
+	ctx.KVStore(k.storeKey).Delete(types.MigratedContractToDenomKey(newContract.Bytes()))
+	return newContract, nil
+}
+
+// pauseContract stops the transfers of the contract migrated from, the auto-deployed contract is owned by the module,
+// an external one must implement the `stop` and `stopped` methods of `ModuleCRC21` and be owned by the module too,
+// otherwise its tokens would stay transferable while disconnected from the native token.
+func (k Keeper) pauseContract(ctx sdk.Context, contract common.Address) error {
+	if _, err := k.CallModuleCRC21(ctx, contract, "stop"); err != nil {
+		return errors.Wrapf(err, "contract %s can't be paused by the module", contract.Hex())
+	}
+	ret, err := k.CallModuleCRC21(ctx, contract, "stopped")
+	if err != nil {
+		return errors.Wrapf(err, "contract %s can't be paused by the module", contract.Hex())
+	}
+	if new(big.Int).SetBytes(ret).Sign() == 0 {
+		return fmt.Errorf("contract %s can't be paused by the module", contract.Hex())
+	}
+	return nil
+}
+
+// SetMigratedContract records the contract the denom is migrated from, and disconnect it from the native token.
+func (k Keeper) SetMigratedContract(ctx sdk.Context, denom string, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	if existing, found := k.GetDenomByContract(ctx, contract); found && existing == denom {
+		store.Delete(types.ContractToDenomKey(contract.Bytes()))
+	}
+	store.Set(types.MigratedContractToDenomKey(contract.Bytes()), []byte(denom))
+}
+
+// GetMigratedContractDenom returns the denom migrated from the contract
+func (k Keeper) GetMigratedContractDenom(ctx sdk.Context, contract common.Address) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MigratedContractToDenomKey(contract.Bytes()))
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetMigratedContracts returns all the contracts the denoms are migrated from
+func (k Keeper) GetMigratedContracts(ctx sdk.Context) (out []types.TokenMapping) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMigratedContractToDenom).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, types.TokenMapping{
+			Denom:    string(iter.Value()),
+			Contract: common.BytesToAddress(iter.Key()).Hex(),
+		})
+	}
+	return
+}
+
+// MigrateTokenBalances moves the balances of the holders from the migrated contract to the current contract of the
+// denom, together with the native coins backing them, returns the total amount migrated.
+func (k Keeper) MigrateTokenBalances(ctx sdk.Context, contract common.Address, holders []common.Address) (sdkmath.Int, error) {
+	denom, found := k.GetMigratedContractDenom(ctx, contract)
+	if !found {
+		return sdkmath.Int{}, errors.Wrapf(sdkerrors.ErrNotFound, "contract %s is not migrated", contract.Hex())
+	}
+	newContract, found := k.GetContractByDenom(ctx, denom)
+	if !found {
+		return sdkmath.Int{}, fmt.Errorf("no contract found for the denom %s", denom)
+	}
+
+	total := sdkmath.ZeroInt()
+	for _, holder := range holders {
+		res, err := k.CallModuleCRC21(ctx, contract, "balanceOf", holder)
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		amount := sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(res))
+		if amount.IsZero() {
+			continue
+		}
+
+		if _, err := k.CallModuleCRC21(ctx, contract, "burn_by_cronos_module", holder, amount.BigInt()); err != nil {
+			return sdkmath.Int{}, err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+		if err := k.bankKeeper.SendCoins(ctx, sdk.AccAddress(contract.Bytes()), sdk.AccAddress(newContract.Bytes()), coins); err != nil {
+			return sdkmath.Int{}, err
+		}
+		if _, err := k.CallModuleCRC21(ctx, newContract, "mint_by_cronos_module", holder, amount.BigInt()); err != nil {
+			return sdkmath.Int{}, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			types.NewMigrateTokenBalanceEvent(contract.Hex(), newContract.Hex(), holder.Hex(), amount),
+		)
+		total = total.Add(amount)
+	}
+	return total, nil
+}
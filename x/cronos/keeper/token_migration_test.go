@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cronosmodulekeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+func (suite *KeeperTestSuite) TestMigrateTokenContract() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+	authority := keeper.GetAuthority()
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	holder := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	other := common.BigToAddress(big.NewInt(1))
+
+	amount := sdkmath.NewInt(100)
+	coins := sdk.NewCoins(sdk.NewCoin(CorrectIbcDenom, amount))
+	suite.Require().NoError(suite.MintCoins(sdk.AccAddress(holder.Bytes()), coins))
+	suite.Require().NoError(keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, holder, coins, true))
+	autoContract, found := keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().True(found)
+
+	// a compatible contract as the external one, the module nonce is not bumped by internal calls.
+	account := suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, types.EVMModuleAddress)
+	account.Nonce++
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, types.EVMModuleAddress, account))
+	external, err := keeper.DeployModuleCRC21(suite.ctx, CorrectIbcDenom)
+	suite.Require().NoError(err)
+
+	balanceOf := func(contract, addr common.Address) *big.Int {
+		ret, err := keeper.CallModuleCRC21(suite.ctx, contract, "balanceOf", addr)
+		suite.Require().NoError(err)
+		return new(big.Int).SetBytes(ret)
+	}
+
+	// only governance
+	_, err = msgServer.MigrateTokenContract(suite.ctx, types.NewMsgMigrateTokenContract(suite.address.String(), CorrectIbcDenom, external.Hex()))
+	suite.Require().Error(err)
+	// not mapped
+	_, err = msgServer.MigrateTokenContract(suite.ctx, types.NewMsgMigrateTokenContract(authority, denom, external.Hex()))
+	suite.Require().Error(err)
+	// already the auto-deployed one
+	_, err = msgServer.MigrateTokenContract(suite.ctx, types.NewMsgMigrateTokenContract(authority, CorrectIbcDenom, ""))
+	suite.Require().Error(err)
+
+	rsp, err := msgServer.MigrateTokenContract(suite.ctx, types.NewMsgMigrateTokenContract(authority, CorrectIbcDenom, external.Hex()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(external.Hex(), rsp.Contract)
+
+	contract, _ := keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+	suite.Require().Equal(external, contract)
+	_, found = keeper.GetDenomByContract(suite.ctx, autoContract)
+	suite.Require().False(found)
+	ret, err := keeper.CallModuleCRC21(suite.ctx, autoContract, "stopped")
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(1), new(big.Int).SetBytes(ret))
+	suite.Require().Equal([]types.TokenMapping{
+		{Denom: CorrectIbcDenom, Contract: autoContract.Hex()},
+	}, keeper.GetMigratedContracts(suite.ctx))
+
+	// migrate balances
+	_, err = msgServer.MigrateTokenBalances(suite.ctx, types.NewMsgMigrateTokenBalances(suite.address.String(), external.Hex(), []string{holder.Hex()}))
+	suite.Require().Error(err)
+	_, err = msgServer.MigrateTokenBalances(suite.ctx, types.NewMsgMigrateTokenBalances(
+		suite.address.String(), autoContract.Hex(), []string{holder.Hex(), other.Hex(), holder.Hex()},
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, balanceOf(autoContract, holder).Sign())
+	suite.Require().Equal(amount.BigInt(), balanceOf(external, holder))
+	suite.Require().True(suite.GetBalance(sdk.AccAddress(autoContract.Bytes()), CorrectIbcDenom).IsZero())
+	suite.Require().Equal(amount, suite.GetBalance(sdk.AccAddress(external.Bytes()), CorrectIbcDenom).Amount)
+
+	// the migrated tokens can be converted back
+	suite.Require().NoError(keeper.ConvertCoinFromCRC21ToNative(suite.ctx, external, holder, amount))
+	suite.Require().Equal(amount, suite.GetBalance(sdk.AccAddress(holder.Bytes()), CorrectIbcDenom).Amount)
+	suite.Require().NoError(keeper.ConvertCoinsFromNativeToCRC21(suite.ctx, holder, coins, false))
+
+	// migrate back to the auto-deployed contract, which is resumed
+	rsp, err = msgServer.MigrateTokenContract(suite.ctx, types.NewMsgMigrateTokenContract(authority, CorrectIbcDenom, ""))
+	suite.Require().NoError(err)
+	suite.Require().Equal(autoContract.Hex(), rsp.Contract)
+	ret, err = keeper.CallModuleCRC21(suite.ctx, autoContract, "stopped")
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, new(big.Int).SetBytes(ret).Sign())
+	mapped, found := keeper.GetDenomByContract(suite.ctx, autoContract)
+	suite.Require().True(found)
+	suite.Require().Equal(CorrectIbcDenom, mapped)
+	suite.Require().Equal([]types.TokenMapping{
+		{Denom: CorrectIbcDenom, Contract: external.Hex()},
+	}, keeper.GetMigratedContracts(suite.ctx))
+	// the external contract is paused too
+	ret, err = keeper.CallModuleCRC21(suite.ctx, external, "stopped")
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(1), new(big.Int).SetBytes(ret))
+
+	_, err = keeper.MigrateTokenBalances(suite.ctx, external, []common.Address{holder})
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount.BigInt(), balanceOf(autoContract, holder))
+}
+
+func (suite *KeeperTestSuite) TestMigrateFromUnpausableContract() {
+	suite.SetupTest()
+	keeper := suite.app.CronosKeeper
+	msgServer := cronosmodulekeeper.NewMsgServerImpl(keeper)
+	authority := keeper.GetAuthority()
+
+	autoContract, err := keeper.DeployModuleCRC21(suite.ctx, CorrectIbcDenom)
+	suite.Require().NoError(err)
+	keeper.SetAutoContractForDenom(suite.ctx, CorrectIbcDenom, autoContract)
+
+	// an external contract without the pausable interface
+	external := common.BigToAddress(big.NewInt(1000))
+	suite.Require().NoError(keeper.SetExternalContractForDenom(suite.ctx, CorrectIbcDenom, external))
+
+	_, err = msgServer.MigrateTokenContract(suite.ctx, types.NewMsgMigrateTokenContract(authority, CorrectIbcDenom, ""))
+	suite.Require().Error(err)
+	other := common.BigToAddress(big.NewInt(1001))
+	_, err = msgServer.MigrateTokenContract(suite.ctx, types.NewMsgMigrateTokenContract(authority, CorrectIbcDenom, other.Hex()))
+	suite.Require().Error(err)
+}
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"fmt"
+
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,21 +13,38 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	cronoskeeper "github.com/crypto-org-chain/cronos/v2/x/cronos/keeper"
+	cronostypes "github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ porttypes.UpgradableModule      = (*IBCConversionModule)(nil)
+	_ porttypes.PacketDataUnmarshaler = (*IBCConversionModule)(nil)
 )
 
-var _ porttypes.UpgradableModule = (*IBCConversionModule)(nil)
+// ChannelKeeper defines the expected channel keeper to acknowledge the received packets asynchronously.
+type ChannelKeeper interface {
+	LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error)
+}
 
 // IBCConversionModule implements the ICS26 interface.
 type IBCConversionModule struct {
-	app          porttypes.IBCModule
-	cronoskeeper cronoskeeper.Keeper
+	app           porttypes.IBCModule
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper ChannelKeeper
+	cronoskeeper  cronoskeeper.Keeper
 }
 
-// NewIBCConversionModule creates a new IBCModule given the keeper and underlying application
-func NewIBCConversionModule(app porttypes.IBCModule, ck cronoskeeper.Keeper) IBCConversionModule {
+// NewIBCConversionModule creates a new IBCModule given the keeper and underlying application, the ics4Wrapper and
+// channelKeeper are used to acknowledge the forwarded packets.
+func NewIBCConversionModule(
+	app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, channelKeeper ChannelKeeper, ck cronoskeeper.Keeper,
+) IBCConversionModule {
 	return IBCConversionModule{
-		app:          app,
-		cronoskeeper: ck,
+		app:           app,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		cronoskeeper:  ck,
 	}
 }
 
@@ -101,6 +120,39 @@ func (im IBCConversionModule) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
+	var (
+		hook    *cronostypes.EvmHook
+		forward *cronostypes.ForwardMetadata
+	)
+	// reject the packet before processing if the conversion is disabled, so the tokens are refunded on the source chain.
+	if data, err := im.getFungibleTokenPacketData(packet); err == nil {
+		denom := im.getIbcDenomFromPacketAndData(packet, data)
+		if im.canBeConverted(ctx, denom) {
+			if err := im.cronoskeeper.CheckBridgeEnabled(
+				ctx, cronostypes.BridgeDirectionInbound, denom, packet.GetDestChannel(),
+			); err != nil {
+				return channeltypes.NewErrorAcknowledgement(err)
+			}
+			// the state changes are discarded if the packet is rejected later on.
+			if amount, ok := sdkmath.NewIntFromString(data.Amount); ok {
+				if _, err := im.cronoskeeper.CheckAndUpdateRateLimits(
+					ctx, cronostypes.BridgeDirectionInbound, denom, packet.GetDestChannel(), amount,
+				); err != nil {
+					return channeltypes.NewErrorAcknowledgement(err)
+				}
+			}
+		}
+
+		hook, packet, err = im.prepareEvmHook(ctx, packet, data, denom)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		forward, packet, err = im.prepareForward(packet, data, hook != nil)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
 	ack := im.app.OnRecvPacket(ctx, packet, relayer)
 	if ack.Success() {
 		data, err := im.getFungibleTokenPacketData(packet)
@@ -109,8 +161,20 @@ func (im IBCConversionModule) OnRecvPacket(
 				"cannot unmarshal ICS-20 transfer packet data in middleware"))
 		}
 		denom := im.getIbcDenomFromPacketAndData(packet, data)
-		// Check if it can be converted
-		if im.canBeConverted(ctx, denom) {
+		if hook != nil {
+			// the state changes are discarded together with the received tokens if the hook fails.
+			if err := im.onRecvEvmHook(ctx, data, denom, *hook); err != nil {
+				return channeltypes.NewErrorAcknowledgement(err)
+			}
+		} else if forward != nil {
+			if err := im.onRecvForward(ctx, packet, data, denom, *forward); err != nil {
+				return channeltypes.NewErrorAcknowledgement(err)
+			}
+			// the packet is acknowledged asynchronously when the forwarded packet completes, with an error if it
+			// fails, so the tokens are refunded to the original sender on the counterparty chain.
+			return nil
+		} else if im.canBeConverted(ctx, denom) {
+			// Check if it can be converted
 			err = im.convertVouchers(ctx, data, denom, false)
 			if err != nil {
 				return channeltypes.NewErrorAcknowledgement(err)
@@ -121,6 +185,143 @@ func (im IBCConversionModule) OnRecvPacket(
 	return ack
 }
 
+// prepareEvmHook parses the evm hook from the packet memo, if found, the receiver of the packet is replaced with the
+// intermediate sender derived from the original sender, the receiver in the memo must be the hook contract.
+func (im IBCConversionModule) prepareEvmHook(
+	ctx sdk.Context, packet channeltypes.Packet, data transferTypes.FungibleTokenPacketData, denom string,
+) (*cronostypes.EvmHook, channeltypes.Packet, error) {
+	hook, found, err := cronostypes.ParseEvmHook(data.Memo)
+	if err != nil || !found {
+		return nil, packet, err
+	}
+	if !im.canBeConverted(ctx, denom) {
+		return nil, packet, fmt.Errorf("evm hook is not supported for the denom %s", denom)
+	}
+	receiver, err := parseEvmAddress(data.Receiver)
+	if err != nil {
+		return nil, packet, err
+	}
+	if receiver != hook.Contract {
+		return nil, packet, fmt.Errorf("receiver %s doesn't match the evm hook contract %s", data.Receiver, hook.Contract.Hex())
+	}
+
+	sender := cronostypes.DeriveEvmHookSender(packet.GetDestChannel(), data.Sender)
+	data.Receiver = sdk.AccAddress(sender.Bytes()).String()
+	packet.Data = data.GetBytes()
+	return hook, packet, nil
+}
+
+func (im IBCConversionModule) onRecvEvmHook(
+	ctx sdk.Context, data transferTypes.FungibleTokenPacketData, denom string, hook cronostypes.EvmHook,
+) error {
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return errors.Wrapf(transferTypes.ErrInvalidAmount,
+			"unable to parse transfer amount (%s) into sdk.Int in middleware", data.Amount)
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+	return im.cronoskeeper.OnRecvEvmHook(ctx, common.BytesToAddress(receiver), sdk.NewCoin(denom, amount), hook)
+}
+
+// prepareForward parses the forward metadata from the packet memo, if found, the receiver of the packet is replaced
+// with the intermediate sender derived from the original sender, the receiver on cronos is ignored.
+func (im IBCConversionModule) prepareForward(
+	packet channeltypes.Packet, data transferTypes.FungibleTokenPacketData, hasHook bool,
+) (*cronostypes.ForwardMetadata, channeltypes.Packet, error) {
+	forward, found, err := cronostypes.ParseForwardMetadata(data.Memo)
+	if err != nil || !found {
+		return nil, packet, err
+	}
+	if hasHook {
+		return nil, packet, fmt.Errorf("evm hook and forward can't be used in the same memo")
+	}
+
+	data.Receiver = cronostypes.DeriveForwardSender(packet.GetDestChannel(), data.Sender).String()
+	packet.Data = data.GetBytes()
+	return forward, packet, nil
+}
+
+func (im IBCConversionModule) onRecvForward(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transferTypes.FungibleTokenPacketData,
+	denom string,
+	forward cronostypes.ForwardMetadata,
+) error {
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return errors.Wrapf(transferTypes.ErrInvalidAmount,
+			"unable to parse transfer amount (%s) into sdk.Int in middleware", data.Amount)
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+	return im.cronoskeeper.ForwardIbcTransfer(
+		ctx, receiver, sdk.NewCoin(denom, amount), forward, packet, data.Sender,
+	)
+}
+
+// refundForwardedPacket acknowledges the received packet of the forwarded one with the error, after reverting the
+// receiving of the refunded tokens, so they are refunded to the original sender by the counterparty chain, returns
+// false if it's not a forwarded packet.
+func (im IBCConversionModule) refundForwardedPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data transferTypes.FungibleTokenPacketData, denom string, ackErr error,
+) (bool, error) {
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return false, errors.Wrapf(transferTypes.ErrInvalidAmount,
+			"unable to parse transfer amount (%s) into sdk.Int in middleware", data.Amount)
+	}
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return false, err
+	}
+	forwarded, found, err := im.cronoskeeper.OnForwardedPacketRefunded(
+		ctx, packet.GetSourceChannel(), packet.GetSequence(), sender, sdk.NewCoin(denom, amount),
+	)
+	if err != nil || !found {
+		return found, err
+	}
+	return true, im.writeForwardAck(ctx, forwarded.RefundPacket, channeltypes.NewErrorAcknowledgement(ackErr))
+}
+
+// ackForwardedPacket acknowledges the received packet of the forwarded one with success, if found.
+func (im IBCConversionModule) ackForwardedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	forwarded, found := im.cronoskeeper.GetForwardedPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	im.cronoskeeper.DeleteForwardedPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	return im.writeForwardAck(ctx, forwarded.RefundPacket, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+}
+
+// writeForwardAck writes the asynchronous acknowledgement of the received packet.
+func (im IBCConversionModule) writeForwardAck(
+	ctx sdk.Context, packet channeltypes.Packet, ack exported.Acknowledgement,
+) error {
+	_, chanCap, err := im.channelKeeper.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve module from port-id")
+	}
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// parseEvmAddress parses the address in either hex or bech32 format.
+func parseEvmAddress(s string) (common.Address, error) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver %s", s)
+	}
+	return common.BytesToAddress(addr), nil
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (im IBCConversionModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
@@ -136,15 +337,26 @@ func (im IBCConversionModule) OnAcknowledgementPacket(
 			return errors.Wrapf(sdkerrors.ErrUnknownRequest,
 				"cannot unmarshal ICS-20 transfer packet acknowledgement in middleware: %v", err)
 		}
-		if _, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		if ackErr, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
 			data, err := im.getFungibleTokenPacketData(packet)
 			if err != nil {
 				return err
 			}
 			denom := im.getIbcDenomFromDataForRefund(data)
+			if err := im.revertRateLimitOutflow(ctx, packet, data, denom); err != nil {
+				return err
+			}
+			if refunded, err := im.refundForwardedPacket(
+				ctx, packet, data, denom, fmt.Errorf("forwarded packet failed: %s", ackErr.Error),
+			); err != nil || refunded {
+				return err
+			}
 			if im.canBeConverted(ctx, denom) {
 				return im.convertVouchers(ctx, data, denom, true)
 			}
+		} else {
+			im.cronoskeeper.DeleteRateLimitPendingPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+			return im.ackForwardedPacket(ctx, packet)
 		}
 	}
 
@@ -165,6 +377,14 @@ func (im IBCConversionModule) OnTimeoutPacket(
 			return err
 		}
 		denom := im.getIbcDenomFromDataForRefund(data)
+		if err := im.revertRateLimitOutflow(ctx, packet, data, denom); err != nil {
+			return err
+		}
+		if refunded, err := im.refundForwardedPacket(
+			ctx, packet, data, denom, fmt.Errorf("forwarded packet timed out"),
+		); err != nil || refunded {
+			return err
+		}
 		if im.canBeConverted(ctx, denom) {
 			return im.convertVouchers(ctx, data, denom, true)
 		}
@@ -172,6 +392,18 @@ func (im IBCConversionModule) OnTimeoutPacket(
 	return err
 }
 
+// revertRateLimitOutflow reverts the outflow accounted by the rate limits when the tokens are refunded.
+func (im IBCConversionModule) revertRateLimitOutflow(
+	ctx sdk.Context, packet channeltypes.Packet, data transferTypes.FungibleTokenPacketData, denom string,
+) error {
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return errors.Wrapf(transferTypes.ErrInvalidAmount,
+			"unable to parse transfer amount (%s) into sdk.Int in middleware", data.Amount)
+	}
+	return im.cronoskeeper.RevertRateLimitOutflow(ctx, packet.GetSourceChannel(), packet.GetSequence(), denom, amount)
+}
+
 func (im IBCConversionModule) getFungibleTokenPacketData(packet channeltypes.Packet) (transferTypes.FungibleTokenPacketData, error) {
 	var data transferTypes.FungibleTokenPacketData
 	if err := transferTypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
@@ -273,3 +505,12 @@ func (im IBCConversionModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelI
 	}
 	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
 }
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface, required by the callbacks middleware
+func (im IBCConversionModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	unmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "underlying app doesn't implement PacketDataUnmarshaler: %T", im.app)
+	}
+	return unmarshaler.UnmarshalPacketData(bz)
+}
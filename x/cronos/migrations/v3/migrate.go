@@ -0,0 +1,44 @@
+package v3
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+)
+
+// Migrate migrates the x/cronos module state from the consensus version 2 to
+// version 3. Specifically, it converts the permission bitmasks of the accounts
+// into the grants of the built-in roles, which never expire.
+func Migrate(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	legacyStore := prefix.NewStore(store, types.KeyPrefixAdminToPermissions)
+	iter := legacyStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		address := sdk.AccAddress(iter.Key())
+		permissions := sdk.BigEndianToUint64(iter.Value())
+		for _, pr := range types.PermissionRoles {
+			if permissions&pr.Permission != pr.Permission {
+				continue
+			}
+			grant := types.RoleGrant{
+				Address: address.String(),
+				Role:    pr.Role,
+			}
+			bz, err := cdc.Marshal(&grant)
+			if err != nil {
+				return err
+			}
+			store.Set(types.RoleGrantKey(address, pr.Role), bz)
+		}
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		legacyStore.Delete(key)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package v3_test
+
+import (
+	"testing"
+
+	simappparams "cosmossdk.io/simapp/params"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v3 "github.com/crypto-org-chain/cronos/v2/x/cronos/migrations/v3"
+	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("test"))
+	store := ctx.KVStore(storeKey)
+	cdc := simappparams.MakeTestEncodingConfig().Codec
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	store.Set(types.AdminToPermissionsKey(addr1), sdk.Uint64ToBigEndian(types.PermissionTurnBridge))
+	store.Set(types.AdminToPermissionsKey(addr2), sdk.Uint64ToBigEndian(types.PermissionChangeTokenMapping|types.PermissionTurnBridge))
+
+	require.NoError(t, v3.Migrate(store, cdc))
+
+	require.False(t, store.Has(types.AdminToPermissionsKey(addr1)))
+	require.False(t, store.Has(types.AdminToPermissionsKey(addr2)))
+	for _, tc := range []struct {
+		address sdk.AccAddress
+		role    string
+		granted bool
+	}{
+		{addr1, types.RoleTokenMappingOperator, false},
+		{addr1, types.RoleBridgeOperator, true},
+		{addr2, types.RoleTokenMappingOperator, true},
+		{addr2, types.RoleBridgeOperator, true},
+	} {
+		bz := store.Get(types.RoleGrantKey(tc.address, tc.role))
+		if !tc.granted {
+			require.Nil(t, bz)
+			continue
+		}
+		var grant types.RoleGrant
+		require.NoError(t, cdc.Unmarshal(bz, &grant))
+		require.Equal(t, types.RoleGrant{Address: tc.address.String(), Role: tc.role}, grant)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -369,6 +370,44 @@ func (api *CronosAPI) ReplayBlock(blockNrOrHash rpctypes.BlockNumberOrHash, post
 	return receipts, nil
 }
 
+// GetTokenMappings returns the token mappings with the token metadata, ordered by contract address,
+// pass the returned `nextKey` as `pageKey` to fetch the next page, `limit` is optional.
+func (api *CronosAPI) GetTokenMappings(pageKey *hexutil.Bytes, limit *hexutil.Uint64) (map[string]interface{}, error) {
+	api.logger.Debug("cronos_getTokenMappings")
+	req := &types.QueryTokenMappingsRequest{
+		Pagination: &query.PageRequest{},
+	}
+	if pageKey != nil {
+		req.Pagination.Key = *pageKey
+	}
+	if limit != nil {
+		req.Pagination.Limit = uint64(*limit)
+	}
+	rsp, err := api.cronosQueryClient.TokenMappings(api.ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := make([]map[string]interface{}, 0, len(rsp.TokenMappings))
+	for _, m := range rsp.TokenMappings {
+		mappings = append(mappings, map[string]interface{}{
+			"denom":        m.Denom,
+			"contract":     common.HexToAddress(m.Contract),
+			"autoDeployed": m.AutoDeployed,
+			"symbol":       m.Symbol,
+			"decimals":     hexutil.Uint(m.Decimals),
+		})
+	}
+	var nextKey hexutil.Bytes
+	if rsp.Pagination != nil {
+		nextKey = rsp.Pagination.NextKey
+	}
+	return map[string]interface{}{
+		"tokenMappings": mappings,
+		"nextKey":       nextKey,
+	}, nil
+}
+
 // getBlock returns the block from BlockNumberOrHash
 func (api *CronosAPI) getBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (blk *coretypes.ResultBlock, err error) {
 	if blockNrOrHash.BlockHash != nil {
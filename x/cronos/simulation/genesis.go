@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 )
@@ -18,6 +20,9 @@ const (
 	cronosAdminKey          = "cronos_admin"
 	enableAutoDeploymentKey = "enable_auto_deployment"
 	maxCallbackGasKey       = "max_callback_gas"
+	enableBankPrecompileKey = "enable_bank_precompile"
+	execMsgTypeUrlsKey      = "exec_msg_type_urls"
+	logRetryWindowKey       = "log_retry_window"
 )
 
 func GenIbcCroDenom(r *rand.Rand) string {
@@ -45,6 +50,21 @@ func GenMaxCallbackGas(r *rand.Rand) uint64 {
 	return maxCallbackGas
 }
 
+func GenEnableBankPrecompile(r *rand.Rand) bool {
+	return r.Intn(2) > 0
+}
+
+func GenExecMsgTypeUrls(r *rand.Rand) []string {
+	if r.Intn(2) > 0 {
+		return []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	}
+	return []string{}
+}
+
+func GenLogRetryWindow(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000))
+}
+
 // RandomizedGenState generates a random GenesisState for the cronos module
 func RandomizedGenState(simState *module.SimulationState) {
 	// cronos params
@@ -54,6 +74,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		cronosAdmin          string
 		enableAutoDeployment bool
 		maxCallbackGas       uint64
+		enableBankPrecompile bool
+		execMsgTypeUrls      []string
+		logRetryWindow       uint64
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -81,7 +104,25 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxCallbackGas = GenIbcTimeout(r) },
 	)
 
-	params := types.NewParams(ibcCroDenom, ibcTimeout, cronosAdmin, enableAutoDeployment, maxCallbackGas)
+	simState.AppParams.GetOrGenerate(
+		enableBankPrecompileKey, &enableBankPrecompile, simState.Rand,
+		func(r *rand.Rand) { enableBankPrecompile = GenEnableBankPrecompile(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		execMsgTypeUrlsKey, &execMsgTypeUrls, simState.Rand,
+		func(r *rand.Rand) { execMsgTypeUrls = GenExecMsgTypeUrls(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		logRetryWindowKey, &logRetryWindow, simState.Rand,
+		func(r *rand.Rand) { logRetryWindow = GenLogRetryWindow(r) },
+	)
+
+	params := types.NewParams(
+		ibcCroDenom, ibcTimeout, cronosAdmin, enableAutoDeployment, maxCallbackGas, enableBankPrecompile, execMsgTypeUrls,
+		logRetryWindow,
+	)
 	cronosGenesis := &types.GenesisState{
 		Params:            params,
 		ExternalContracts: nil,
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Validate performs a stateless validation of the bank allowance
+func (a BankAllowance) Validate() error {
+	for _, addr := range []string{a.Token, a.Owner, a.Spender} {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid address of bank allowance: %s", addr)
+		}
+	}
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount of bank allowance: %s", a.Amount)
+	}
+	return nil
+}
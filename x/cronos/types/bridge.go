@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// Directions returns the concrete directions the bridge direction covers.
+func (d BridgeDirection) Directions() []BridgeDirection {
+	if d == BridgeDirectionUnspecified {
+		return []BridgeDirection{BridgeDirectionInbound, BridgeDirectionOutbound}
+	}
+	return []BridgeDirection{d}
+}
+
+// ParseBridgeDirection parses the bridge direction from "inbound", "outbound" or "both"/empty string,
+// the enum names are accepted too.
+func ParseBridgeDirection(s string) (BridgeDirection, error) {
+	switch strings.ToLower(s) {
+	case "", "both":
+		return BridgeDirectionUnspecified, nil
+	case "inbound":
+		return BridgeDirectionInbound, nil
+	case "outbound":
+		return BridgeDirectionOutbound, nil
+	}
+	if v, ok := BridgeDirection_value[s]; ok {
+		return BridgeDirection(v), nil
+	}
+	return BridgeDirectionUnspecified, fmt.Errorf("invalid bridge direction: %s", s)
+}
+
+// ValidateBridgeSwitch validates the fields of a bridge switch, empty denom or channel id are allowed.
+func ValidateBridgeSwitch(direction BridgeDirection, denom, channelID string) error {
+	if _, ok := BridgeDirection_name[int32(direction)]; !ok {
+		return fmt.Errorf("invalid bridge direction: %d", direction)
+	}
+	if denom != "" {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+	if channelID != "" && !channeltypes.IsValidChannelID(channelID) {
+		return fmt.Errorf("invalid channel id: %s", channelID)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the bridge switch
+func (s BridgeSwitch) Validate() error {
+	if s.Direction == BridgeDirectionUnspecified {
+		return fmt.Errorf("bridge direction is unspecified")
+	}
+	return ValidateBridgeSwitch(s.Direction, s.Denom, s.ChannelId)
+}
@@ -23,10 +23,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgConvertVouchers{},
+		&MsgConvertVouchersBatch{},
 		&MsgTransferTokens{},
 		&MsgUpdateTokenMapping{},
 		&MsgTurnBridge{},
 		&MsgUpdatePermissions{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgSetRole{},
+		&MsgDeleteRole{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgMigrateTokenContract{},
+		&MsgMigrateTokenBalances{},
+		&MsgSetLogHandler{},
+		&MsgRemoveLogHandler{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -4,18 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +30,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BridgeDirection defines the direction of the bridge flows.
+type BridgeDirection int32
+
+const (
+	// BRIDGE_DIRECTION_UNSPECIFIED means both directions.
+	BridgeDirectionUnspecified BridgeDirection = 0
+	// BRIDGE_DIRECTION_INBOUND means the flows into evm, like the ibc transfers received and the voucher conversions.
+	BridgeDirectionInbound BridgeDirection = 1
+	// BRIDGE_DIRECTION_OUTBOUND means the flows out of evm, like the crc20 tokens sent to native coins or through ibc.
+	BridgeDirectionOutbound BridgeDirection = 2
+)
+
+var BridgeDirection_name = map[int32]string{
+	0: "BRIDGE_DIRECTION_UNSPECIFIED",
+	1: "BRIDGE_DIRECTION_INBOUND",
+	2: "BRIDGE_DIRECTION_OUTBOUND",
+}
+
+var BridgeDirection_value = map[string]int32{
+	"BRIDGE_DIRECTION_UNSPECIFIED": 0,
+	"BRIDGE_DIRECTION_INBOUND":     1,
+	"BRIDGE_DIRECTION_OUTBOUND":    2,
+}
+
+func (x BridgeDirection) String() string {
+	return proto.EnumName(BridgeDirection_name, int32(x))
+}
+
+func (BridgeDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{0}
+}
+
+// LogHandlerAction defines the native actions the registered log handlers are allowed to perform.
+type LogHandlerAction int32
+
+const (
+	// LOG_HANDLER_ACTION_UNSPECIFIED is invalid.
+	LogHandlerActionUnspecified LogHandlerAction = 0
+	// LOG_HANDLER_ACTION_SEND_TO_ACCOUNT sends the native coins of the token contract to an account,
+	// the params are `recipient` (address) and `amount` (uint256).
+	LogHandlerActionSendToAccount LogHandlerAction = 1
+	// LOG_HANDLER_ACTION_SEND_TO_IBC transfers the native coins of the token contract through ibc on behalf of the
+	// sender, the params are `sender` (address), `recipient` (string), `amount` (uint256), and the optional
+	// `channel_id` (uint256) and `memo` (string).
+	LogHandlerActionSendToIbc LogHandlerAction = 2
+)
+
+var LogHandlerAction_name = map[int32]string{
+	0: "LOG_HANDLER_ACTION_UNSPECIFIED",
+	1: "LOG_HANDLER_ACTION_SEND_TO_ACCOUNT",
+	2: "LOG_HANDLER_ACTION_SEND_TO_IBC",
+}
+
+var LogHandlerAction_value = map[string]int32{
+	"LOG_HANDLER_ACTION_UNSPECIFIED":     0,
+	"LOG_HANDLER_ACTION_SEND_TO_ACCOUNT": 1,
+	"LOG_HANDLER_ACTION_SEND_TO_IBC":     2,
+}
+
+func (x LogHandlerAction) String() string {
+	return proto.EnumName(LogHandlerAction_name, int32(x))
+}
+
+func (LogHandlerAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{1}
+}
+
 // Params defines the parameters for the cronos module.
 type Params struct {
 	IbcCroDenom string `protobuf:"bytes,1,opt,name=ibc_cro_denom,json=ibcCroDenom,proto3" json:"ibc_cro_denom,omitempty" yaml:"ibc_cro_denom,omitempty"`
@@ -31,6 +105,13 @@ type Params struct {
 	CronosAdmin          string `protobuf:"bytes,3,opt,name=cronos_admin,json=cronosAdmin,proto3" json:"cronos_admin,omitempty"`
 	EnableAutoDeployment bool   `protobuf:"varint,4,opt,name=enable_auto_deployment,json=enableAutoDeployment,proto3" json:"enable_auto_deployment,omitempty"`
 	MaxCallbackGas       uint64 `protobuf:"varint,5,opt,name=max_callback_gas,json=maxCallbackGas,proto3" json:"max_callback_gas,omitempty"`
+	// enable_bank_precompile enables the bank precompiled contract managing the native coins of the evm tokens.
+	EnableBankPrecompile bool `protobuf:"varint,6,opt,name=enable_bank_precompile,json=enableBankPrecompile,proto3" json:"enable_bank_precompile,omitempty"`
+	// exec_msg_type_urls are the type urls of the messages allowed to be executed by the exec precompiled contract.
+	ExecMsgTypeUrls []string `protobuf:"bytes,7,rep,name=exec_msg_type_urls,json=execMsgTypeUrls,proto3" json:"exec_msg_type_urls,omitempty"`
+	// log_retry_window is the number of blocks the failed native actions triggered by the evm logs are retried before
+	// the tokens are refunded, zero disables the retry queue, and the failures revert the evm tx.
+	LogRetryWindow uint64 `protobuf:"varint,8,opt,name=log_retry_window,json=logRetryWindow,proto3" json:"log_retry_window,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -100,6 +181,27 @@ func (m *Params) GetMaxCallbackGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnableBankPrecompile() bool {
+	if m != nil {
+		return m.EnableBankPrecompile
+	}
+	return false
+}
+
+func (m *Params) GetExecMsgTypeUrls() []string {
+	if m != nil {
+		return m.ExecMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetLogRetryWindow() uint64 {
+	if m != nil {
+		return m.LogRetryWindow
+	}
+	return 0
+}
+
 // TokenMappingChangeProposal defines a proposal to change one token mapping.
 type TokenMappingChangeProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -196,297 +298,4070 @@ func (m *TokenMapping) GetContract() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cronos.Params")
-	proto.RegisterType((*TokenMappingChangeProposal)(nil), "cronos.TokenMappingChangeProposal")
-	proto.RegisterType((*TokenMapping)(nil), "cronos.TokenMapping")
+// BridgeSwitch defines a disabled bridge flow, empty denom or channel_id matches all of them.
+type BridgeSwitch struct {
+	Direction BridgeDirection `protobuf:"varint,1,opt,name=direction,proto3,enum=cronos.BridgeDirection" json:"direction,omitempty"`
+	Denom     string          `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string          `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("cronos/cronos.proto", fileDescriptor_8bc54992a93db2d2) }
-
-var fileDescriptor_8bc54992a93db2d2 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0x7d, 0x25, 0x35, 0xc9, 0xa5, 0x45, 0xe8, 0x88, 0x2a, 0x2b, 0x83, 0x63, 0x3c, 0x79,
-	0xa0, 0xb5, 0x04, 0x9d, 0x32, 0xd1, 0xa6, 0x82, 0x09, 0x54, 0x59, 0x9d, 0x58, 0xac, 0xf3, 0xe5,
-	0xe4, 0x9c, 0xea, 0xbb, 0x77, 0x3a, 0x5f, 0x50, 0xfc, 0x1f, 0x30, 0x32, 0x32, 0xf6, 0x6f, 0x61,
-	0x62, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x1f, 0x30, 0x32, 0x21, 0xfb, 0xd2, 0xd2, 0x0c, 0x9d, 0x7c,
-	0xdf, 0xef, 0x93, 0xf5, 0xbd, 0xef, 0xe9, 0xe1, 0x17, 0xcc, 0x80, 0x82, 0x3a, 0x75, 0x9f, 0x13,
-	0x6d, 0xc0, 0x02, 0xf1, 0x9d, 0x1a, 0x8f, 0x4a, 0x28, 0xa1, 0x43, 0x69, 0xfb, 0x72, 0x6e, 0xfc,
-	0x17, 0x61, 0xff, 0x92, 0x1a, 0x2a, 0x6b, 0xf2, 0x0e, 0x1f, 0x8a, 0x82, 0xe5, 0xcc, 0x40, 0x3e,
-	0xe7, 0x0a, 0x64, 0x80, 0x22, 0x94, 0x0c, 0xce, 0xe3, 0x3f, 0xbf, 0x26, 0x61, 0x43, 0x65, 0x35,
-	0x8d, 0x77, 0xec, 0x57, 0x20, 0x85, 0xe5, 0x52, 0xdb, 0x26, 0xce, 0x86, 0xa2, 0x60, 0x33, 0x03,
-	0x17, 0x2d, 0x27, 0x13, 0xdc, 0xca, 0xdc, 0x0a, 0xc9, 0x61, 0x69, 0x83, 0xbd, 0x08, 0x25, 0xbd,
-	0x0c, 0x8b, 0x82, 0x5d, 0x39, 0x42, 0x5e, 0xe2, 0x03, 0x37, 0x53, 0x4e, 0xe7, 0x52, 0xa8, 0xe0,
-	0x49, 0x9b, 0x93, 0x0d, 0x1d, 0x3b, 0x6b, 0x11, 0x39, 0xc5, 0x47, 0x5c, 0xd1, 0xa2, 0xe2, 0x39,
-	0x5d, 0xda, 0x36, 0x50, 0x57, 0xd0, 0x48, 0xae, 0x6c, 0xd0, 0x8b, 0x50, 0xd2, 0xcf, 0x46, 0xce,
-	0x3d, 0x5b, 0x5a, 0xb8, 0xb8, 0xf7, 0x48, 0x82, 0x9f, 0x4b, 0xba, 0xca, 0x19, 0xad, 0xaa, 0x82,
-	0xb2, 0xeb, 0xbc, 0xa4, 0x75, 0xb0, 0xdf, 0xc5, 0x3f, 0x93, 0x74, 0x35, 0xdb, 0xe2, 0xf7, 0xb4,
-	0x9e, 0xf6, 0xbe, 0xdd, 0x4c, 0xbc, 0xf8, 0x3b, 0xc2, 0xe3, 0x2b, 0xb8, 0xe6, 0xea, 0x03, 0xd5,
-	0x5a, 0xa8, 0x72, 0xb6, 0xa0, 0xaa, 0xe4, 0x97, 0x06, 0x34, 0xd4, 0xb4, 0x22, 0x23, 0xbc, 0x6f,
-	0x85, 0xad, 0xb8, 0x5b, 0x44, 0xe6, 0x04, 0x89, 0xf0, 0x70, 0xce, 0x6b, 0x66, 0x84, 0xb6, 0x02,
-	0x54, 0x57, 0x6f, 0x90, 0x3d, 0x44, 0xed, 0x7f, 0x6e, 0x81, 0xae, 0x98, 0x13, 0x64, 0x8c, 0xfb,
-	0x0c, 0x94, 0x35, 0x94, 0xb9, 0x12, 0x83, 0xec, 0x5e, 0x93, 0x23, 0xec, 0xd7, 0x8d, 0x2c, 0xa0,
-	0xea, 0xc6, 0x1d, 0x64, 0x5b, 0x45, 0x02, 0xfc, 0x74, 0xce, 0x99, 0x90, 0xb4, 0x0a, 0xfc, 0x08,
-	0x25, 0x87, 0xd9, 0x9d, 0x9c, 0xf6, 0xbf, 0xdc, 0x4c, 0xbc, 0xae, 0xc4, 0x5b, 0x7c, 0xf0, 0xb0,
-	0xc3, 0xff, 0x74, 0xf4, 0x58, 0xfa, 0xde, 0x6e, 0xfa, 0xf9, 0xc7, 0x1f, 0xeb, 0x10, 0xdd, 0xae,
-	0x43, 0xf4, 0x7b, 0x1d, 0xa2, 0xaf, 0x9b, 0xd0, 0xbb, 0xdd, 0x84, 0xde, 0xcf, 0x4d, 0xe8, 0x7d,
-	0x3a, 0x2d, 0x85, 0x5d, 0x2c, 0x8b, 0x13, 0x06, 0x32, 0x65, 0xa6, 0xd1, 0x16, 0x8e, 0xc1, 0x94,
-	0xc7, 0x6c, 0x41, 0x85, 0xda, 0x5e, 0x59, 0xfa, 0xf9, 0x75, 0xba, 0xba, 0x7b, 0xdb, 0x46, 0xf3,
-	0xba, 0xf0, 0xbb, 0xd3, 0x7a, 0xf3, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xad, 0x72, 0x13, 0x5e, 0x8f,
-	0x02, 0x00, 0x00,
+func (m *BridgeSwitch) Reset()         { *m = BridgeSwitch{} }
+func (m *BridgeSwitch) String() string { return proto.CompactTextString(m) }
+func (*BridgeSwitch) ProtoMessage()    {}
+func (*BridgeSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{3}
+}
+func (m *BridgeSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeSwitch.Merge(m, src)
+}
+func (m *BridgeSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeSwitch.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeSwitch proto.InternalMessageInfo
+
+func (m *BridgeSwitch) GetDirection() BridgeDirection {
+	if m != nil {
+		return m.Direction
 	}
-	return dAtA[:n], nil
+	return BridgeDirectionUnspecified
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BridgeSwitch) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxCallbackGas != 0 {
-		i = encodeVarintCronos(dAtA, i, uint64(m.MaxCallbackGas))
-		i--
-		dAtA[i] = 0x28
+func (m *BridgeSwitch) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	if m.EnableAutoDeployment {
-		i--
-		if m.EnableAutoDeployment {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// RateLimit defines the caps of the ibc transfers of a denom in a time window.
+type RateLimit struct {
+	// denom is the native denom, the CRC21 tokens are accounted as the native denom they are mapped to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// channel_id limits to a single ibc channel, empty means the aggregated flows through all channels.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// max_percent_send is the cap of the outflow in percentage of the supply at the start of the window,
+	// zero means no limit.
+	MaxPercentSend cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_send"`
+	// max_percent_recv is the cap of the inflow in percentage of the supply at the start of the window,
+	// zero means no limit.
+	MaxPercentRecv cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_percent_recv"`
+	// max_amount_send is the absolute cap of the outflow, zero means no limit.
+	MaxAmountSend cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_amount_send,json=maxAmountSend,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_send"`
+	// max_amount_recv is the absolute cap of the inflow, zero means no limit.
+	MaxAmountRecv cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_amount_recv,json=maxAmountRecv,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_recv"`
+	// window is the duration of the time window, the usage is reset when the window expires.
+	Window time.Duration `protobuf:"bytes,7,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{4}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CronosAdmin) > 0 {
-		i -= len(m.CronosAdmin)
-		copy(dAtA[i:], m.CronosAdmin)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.CronosAdmin)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.IbcTimeout != 0 {
-		i = encodeVarintCronos(dAtA, i, uint64(m.IbcTimeout))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.IbcCroDenom) > 0 {
-		i -= len(m.IbcCroDenom)
-		copy(dAtA[i:], m.IbcCroDenom)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.IbcCroDenom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *RateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TokenMappingChangeProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RateLimitUsage defines the flows accounted in the current window of a rate limit.
+type RateLimitUsage struct {
+	Denom     string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string                `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Inflow    cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	Outflow   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+	// supply is the snapshot of the supply at the start of the window.
+	Supply      cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	WindowStart time.Time             `protobuf:"bytes,6,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
 }
 
-func (m *TokenMappingChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Decimal != 0 {
-		i = encodeVarintCronos(dAtA, i, uint64(m.Decimal))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{5}
+}
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
+}
+func (m *RateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
 }
 
-func (m *TokenMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
+
+func (m *RateLimitUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TokenMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitUsage) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *TokenMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *RateLimitUsage) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func encodeVarintCronos(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCronos(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// Role defines a named set of messages the holders are allowed to execute.
+type Role struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_types are the type urls of the allowed messages, like "/cronos.MsgTurnBridge".
+	MsgTypes []string `protobuf:"bytes,2,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+}
+
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{6}
+}
+func (m *Role) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
+}
+func (m *Role) XXX_Size() int {
+	return m.Size()
+}
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Role proto.InternalMessageInfo
+
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	l = len(m.IbcCroDenom)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	return ""
+}
+
+func (m *Role) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
 	}
-	if m.IbcTimeout != 0 {
-		n += 1 + sovCronos(uint64(m.IbcTimeout))
+	return nil
+}
+
+// RoleGrant defines a role granted to an account.
+type RoleGrant struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// expiration is the time the grant expires, the grant never expires if not set.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{7}
+}
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.CronosAdmin)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+}
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
+}
+func (m *RoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
+
+func (m *RoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.EnableAutoDeployment {
-		n += 2
+	return ""
+}
+
+func (m *RoleGrant) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	if m.MaxCallbackGas != 0 {
-		n += 1 + sovCronos(uint64(m.MaxCallbackGas))
+	return ""
+}
+
+func (m *RoleGrant) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	return n
+	return nil
 }
 
-func (m *TokenMappingChangeProposal) Size() (n int) {
-	if m == nil {
-		return 0
+// ForwardedPacket defines an in-flight packet forwarding the tokens received from another chain, the received packet
+// is acknowledged once the forwarded packet completes, with an error if it fails, so the tokens are refunded to the
+// original sender on the counterparty chain.
+type ForwardedPacket struct {
+	// channel_id and sequence identify the forwarded packet.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// refund_channel_id is the channel the tokens are received from.
+	RefundChannelId string `protobuf:"bytes,3,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
+	// refund_receiver is the original sender on the counterparty chain of the refund channel.
+	RefundReceiver string `protobuf:"bytes,4,opt,name=refund_receiver,json=refundReceiver,proto3" json:"refund_receiver,omitempty"`
+	// refund_packet is the received packet, which is not acknowledged until the forwarded packet completes.
+	RefundPacket types.Packet `protobuf:"bytes,5,opt,name=refund_packet,json=refundPacket,proto3" json:"refund_packet"`
+}
+
+func (m *ForwardedPacket) Reset()         { *m = ForwardedPacket{} }
+func (m *ForwardedPacket) String() string { return proto.CompactTextString(m) }
+func (*ForwardedPacket) ProtoMessage()    {}
+func (*ForwardedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{8}
+}
+func (m *ForwardedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+}
+func (m *ForwardedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedPacket.Merge(m, src)
+}
+func (m *ForwardedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedPacket proto.InternalMessageInfo
+
+func (m *ForwardedPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	return ""
+}
+
+func (m *ForwardedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	return 0
+}
+
+func (m *ForwardedPacket) GetRefundChannelId() string {
+	if m != nil {
+		return m.RefundChannelId
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	return ""
+}
+
+func (m *ForwardedPacket) GetRefundReceiver() string {
+	if m != nil {
+		return m.RefundReceiver
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	return ""
+}
+
+func (m *ForwardedPacket) GetRefundPacket() types.Packet {
+	if m != nil {
+		return m.RefundPacket
 	}
-	if m.Decimal != 0 {
-		n += 1 + sovCronos(uint64(m.Decimal))
+	return types.Packet{}
+}
+
+// BankAllowance defines the amount of the native coins of an evm token the spender can transfer on behalf of the
+// owner through the bank precompiled contract.
+type BankAllowance struct {
+	// token is the token contract, the coins are of denom `evm/{token}`.
+	// token, owner and spender are hex addresses.
+	Token   string                `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Owner   string                `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender string                `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *BankAllowance) Reset()         { *m = BankAllowance{} }
+func (m *BankAllowance) String() string { return proto.CompactTextString(m) }
+func (*BankAllowance) ProtoMessage()    {}
+func (*BankAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{9}
+}
+func (m *BankAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BankAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BankAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BankAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BankAllowance.Merge(m, src)
+}
+func (m *BankAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BankAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BankAllowance.DiscardUnknown(m)
 }
 
-func (m *TokenMapping) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BankAllowance proto.InternalMessageInfo
+
+func (m *BankAllowance) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	return ""
+}
+
+func (m *BankAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovCronos(uint64(l))
+	return ""
+}
+
+func (m *BankAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
 	}
-	return n
+	return ""
 }
 
-func sovCronos(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// LogParamBinding binds an argument of the event to a param of the native action.
+type LogParamBinding struct {
+	Param    string `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+	Argument string `protobuf:"bytes,2,opt,name=argument,proto3" json:"argument,omitempty"`
 }
-func sozCronos(x uint64) (n int) {
-	return sovCronos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *LogParamBinding) Reset()         { *m = LogParamBinding{} }
+func (m *LogParamBinding) String() string { return proto.CompactTextString(m) }
+func (*LogParamBinding) ProtoMessage()    {}
+func (*LogParamBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{10}
+}
+func (m *LogParamBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogParamBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogParamBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogParamBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogParamBinding.Merge(m, src)
+}
+func (m *LogParamBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogParamBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogParamBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogParamBinding proto.InternalMessageInfo
+
+func (m *LogParamBinding) GetParam() string {
+	if m != nil {
+		return m.Param
+	}
+	return ""
+}
+
+func (m *LogParamBinding) GetArgument() string {
+	if m != nil {
+		return m.Argument
+	}
+	return ""
+}
+
+// LogHandlerActionBinding defines a native action performed with the params bound to the arguments of the event.
+type LogHandlerActionBinding struct {
+	Action LogHandlerAction  `protobuf:"varint,1,opt,name=action,proto3,enum=cronos.LogHandlerAction" json:"action,omitempty"`
+	Params []LogParamBinding `protobuf:"bytes,2,rep,name=params,proto3" json:"params"`
+}
+
+func (m *LogHandlerActionBinding) Reset()         { *m = LogHandlerActionBinding{} }
+func (m *LogHandlerActionBinding) String() string { return proto.CompactTextString(m) }
+func (*LogHandlerActionBinding) ProtoMessage()    {}
+func (*LogHandlerActionBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{11}
+}
+func (m *LogHandlerActionBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogHandlerActionBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogHandlerActionBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogHandlerActionBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogHandlerActionBinding.Merge(m, src)
+}
+func (m *LogHandlerActionBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogHandlerActionBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogHandlerActionBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogHandlerActionBinding proto.InternalMessageInfo
+
+func (m *LogHandlerActionBinding) GetAction() LogHandlerAction {
+	if m != nil {
+		return m.Action
+	}
+	return LogHandlerActionUnspecified
+}
+
+func (m *LogHandlerActionBinding) GetParams() []LogParamBinding {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// LogHandler defines the native actions performed when the contract emits the event, the actions are restricted to
+// the native coins of the token contract.
+type LogHandler struct {
+	// contract is the hex address of the token contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// event is the json abi of the event, e.g.
+	// `{"type":"event","name":"Bridge","inputs":[{"name":"to","type":"address","indexed":false}]}`.
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// actions are performed in order.
+	Actions []LogHandlerActionBinding `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions"`
+}
+
+func (m *LogHandler) Reset()         { *m = LogHandler{} }
+func (m *LogHandler) String() string { return proto.CompactTextString(m) }
+func (*LogHandler) ProtoMessage()    {}
+func (*LogHandler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{12}
+}
+func (m *LogHandler) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogHandler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogHandler.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogHandler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogHandler.Merge(m, src)
+}
+func (m *LogHandler) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogHandler) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogHandler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogHandler proto.InternalMessageInfo
+
+func (m *LogHandler) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *LogHandler) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *LogHandler) GetActions() []LogHandlerActionBinding {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// FailedLog is an evm log of which the native actions failed, it's retried in the end blocker with backoff until the
+// deadline, then the tokens are refunded to the user.
+type FailedLog struct {
+	// tx_hash is the hex hash of the evm tx emitting the log.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// log_index is the index of the log in the tx receipt.
+	LogIndex uint32 `protobuf:"varint,2,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// contract is the hex address of the contract emitting the log.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// topics are the hex topics of the log.
+	Topics []string `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data   []byte   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// attempts is the number of the failed attempts.
+	Attempts uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// next_retry_height is the block height of the next attempt.
+	NextRetryHeight int64 `protobuf:"varint,7,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+	// deadline_height is the block height the tokens are refunded at, if still failing.
+	DeadlineHeight int64 `protobuf:"varint,8,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	// error is the error of the last attempt.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// sender is the hex address of the sender of the evm tx, the number of the failed logs of a sender is bounded.
+	Sender string `protobuf:"bytes,10,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *FailedLog) Reset()         { *m = FailedLog{} }
+func (m *FailedLog) String() string { return proto.CompactTextString(m) }
+func (*FailedLog) ProtoMessage()    {}
+func (*FailedLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bc54992a93db2d2, []int{13}
+}
+func (m *FailedLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedLog.Merge(m, src)
+}
+func (m *FailedLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedLog proto.InternalMessageInfo
+
+func (m *FailedLog) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *FailedLog) GetLogIndex() uint32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *FailedLog) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *FailedLog) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *FailedLog) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *FailedLog) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *FailedLog) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+func (m *FailedLog) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+func (m *FailedLog) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedLog) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cronos.BridgeDirection", BridgeDirection_name, BridgeDirection_value)
+	proto.RegisterEnum("cronos.LogHandlerAction", LogHandlerAction_name, LogHandlerAction_value)
+	proto.RegisterType((*Params)(nil), "cronos.Params")
+	proto.RegisterType((*TokenMappingChangeProposal)(nil), "cronos.TokenMappingChangeProposal")
+	proto.RegisterType((*TokenMapping)(nil), "cronos.TokenMapping")
+	proto.RegisterType((*BridgeSwitch)(nil), "cronos.BridgeSwitch")
+	proto.RegisterType((*RateLimit)(nil), "cronos.RateLimit")
+	proto.RegisterType((*RateLimitUsage)(nil), "cronos.RateLimitUsage")
+	proto.RegisterType((*Role)(nil), "cronos.Role")
+	proto.RegisterType((*RoleGrant)(nil), "cronos.RoleGrant")
+	proto.RegisterType((*ForwardedPacket)(nil), "cronos.ForwardedPacket")
+	proto.RegisterType((*BankAllowance)(nil), "cronos.BankAllowance")
+	proto.RegisterType((*LogParamBinding)(nil), "cronos.LogParamBinding")
+	proto.RegisterType((*LogHandlerActionBinding)(nil), "cronos.LogHandlerActionBinding")
+	proto.RegisterType((*LogHandler)(nil), "cronos.LogHandler")
+	proto.RegisterType((*FailedLog)(nil), "cronos.FailedLog")
+}
+
+func init() { proto.RegisterFile("cronos/cronos.proto", fileDescriptor_8bc54992a93db2d2) }
+
+var fileDescriptor_8bc54992a93db2d2 = []byte{
+	// 1571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x77, 0xdb, 0x1e, 0xc7, 0x2e, 0xe7, 0x8b, 0x66, 0x98, 0xe9, 0xf1, 0x30, 0xb6, 0xa7, 0x2f,
+	0x44, 0x03, 0x6b, 0xb3, 0x61, 0x57, 0x8b, 0x86, 0x03, 0xe3, 0x8f, 0x4c, 0xc6, 0x52, 0xd6, 0x89,
+	0x3a, 0x8e, 0x90, 0xb8, 0xb4, 0xca, 0xd5, 0x95, 0x76, 0x29, 0xdd, 0x55, 0x4d, 0x75, 0x39, 0xb1,
+	0xe1, 0x80, 0xc4, 0x85, 0x55, 0xc4, 0x61, 0x8f, 0x7b, 0x89, 0xb4, 0x12, 0x17, 0xfe, 0x01, 0xfe,
+	0x01, 0x4e, 0x7b, 0x63, 0x8f, 0x88, 0xc3, 0x80, 0x66, 0x4e, 0x88, 0x1b, 0x7f, 0x01, 0xaa, 0x8f,
+	0x76, 0x1c, 0x67, 0x06, 0xb2, 0x7b, 0x72, 0xbf, 0x57, 0xef, 0xfd, 0xde, 0x67, 0xbd, 0x57, 0x06,
+	0xdf, 0x45, 0x9c, 0x51, 0x96, 0xb6, 0xf5, 0x4f, 0x2b, 0xe1, 0x4c, 0x30, 0xbb, 0xa4, 0xa9, 0xda,
+	0xfd, 0x90, 0x85, 0x4c, 0xb1, 0xda, 0xf2, 0x4b, 0x9f, 0xd6, 0xea, 0x21, 0x63, 0x61, 0x84, 0xdb,
+	0x8a, 0x1a, 0x4f, 0x4f, 0xdb, 0xc1, 0x94, 0x43, 0x41, 0x18, 0x35, 0xe7, 0x8d, 0xd5, 0x73, 0x41,
+	0x62, 0x9c, 0x0a, 0x18, 0x27, 0x46, 0xe0, 0x29, 0x19, 0xa3, 0x36, 0x62, 0x1c, 0xb7, 0xd1, 0x04,
+	0x52, 0x8a, 0xa3, 0xf6, 0xf9, 0x87, 0xd9, 0xa7, 0x16, 0x71, 0x7f, 0x5f, 0x00, 0xa5, 0x23, 0xc8,
+	0x61, 0x9c, 0xda, 0x2f, 0xc1, 0x06, 0x19, 0x23, 0x1f, 0x71, 0xe6, 0x07, 0x98, 0xb2, 0xd8, 0xb1,
+	0x9a, 0xd6, 0x4e, 0xa5, 0xeb, 0xfe, 0xe7, 0x75, 0xa3, 0x3e, 0x87, 0x71, 0xf4, 0xdc, 0xbd, 0x71,
+	0xfc, 0x23, 0x16, 0x13, 0x81, 0xe3, 0x44, 0xcc, 0x5d, 0xaf, 0x4a, 0xc6, 0xa8, 0xc7, 0x59, 0x5f,
+	0xf2, 0xed, 0x06, 0x90, 0xa4, 0x2f, 0x9d, 0x61, 0x53, 0xe1, 0xe4, 0x9b, 0xd6, 0x4e, 0xd1, 0x03,
+	0x64, 0x8c, 0x46, 0x9a, 0x63, 0x3f, 0x05, 0xeb, 0x3a, 0x6e, 0x1f, 0x06, 0x31, 0xa1, 0x4e, 0x41,
+	0xda, 0xf1, 0xaa, 0x9a, 0xd7, 0x91, 0x2c, 0xfb, 0x23, 0xf0, 0x00, 0x53, 0x38, 0x8e, 0xb0, 0x0f,
+	0xa7, 0x42, 0x1a, 0x4c, 0x22, 0x36, 0x8f, 0x31, 0x15, 0x4e, 0xb1, 0x69, 0xed, 0x94, 0xbd, 0xfb,
+	0xfa, 0xb4, 0x33, 0x15, 0xac, 0xbf, 0x38, 0xb3, 0x77, 0xc0, 0x76, 0x0c, 0x67, 0x3e, 0x82, 0x51,
+	0x34, 0x86, 0xe8, 0xcc, 0x0f, 0x61, 0xea, 0xdc, 0x53, 0xe6, 0x37, 0x63, 0x38, 0xeb, 0x19, 0xf6,
+	0x3e, 0x4c, 0x97, 0xf0, 0xc7, 0x90, 0x9e, 0xf9, 0x09, 0xc7, 0x88, 0xc5, 0x09, 0x89, 0xb0, 0x53,
+	0x5a, 0xc6, 0xef, 0x42, 0x7a, 0x76, 0xb4, 0x38, 0xb3, 0x7f, 0x08, 0x6c, 0x3c, 0xc3, 0xc8, 0x8f,
+	0xd3, 0xd0, 0x17, 0xf3, 0x04, 0xfb, 0x53, 0x1e, 0xa5, 0xce, 0x5a, 0xb3, 0xb0, 0x53, 0xf1, 0xb6,
+	0xe4, 0xc9, 0xa7, 0x69, 0x38, 0x9a, 0x27, 0xf8, 0x84, 0x47, 0xa9, 0x74, 0x26, 0x62, 0xa1, 0xcf,
+	0xb1, 0xe0, 0x73, 0xff, 0x82, 0xd0, 0x80, 0x5d, 0x38, 0x65, 0xed, 0x4c, 0xc4, 0x42, 0x4f, 0xb2,
+	0x7f, 0xa1, 0xb8, 0xcf, 0x8b, 0x5f, 0x7c, 0xd9, 0xc8, 0xb9, 0x7f, 0xb1, 0x40, 0x6d, 0xc4, 0xce,
+	0x30, 0xfd, 0x14, 0x26, 0x09, 0xa1, 0x61, 0x6f, 0x02, 0x69, 0x88, 0x8f, 0x38, 0x4b, 0x58, 0x0a,
+	0x23, 0xfb, 0x3e, 0xb8, 0x27, 0x88, 0x88, 0xb0, 0xae, 0x8a, 0xa7, 0x09, 0xbb, 0x09, 0xaa, 0x01,
+	0x4e, 0x11, 0x27, 0x89, 0xec, 0x0b, 0x95, 0xeb, 0x8a, 0xb7, 0xcc, 0x92, 0x7a, 0xba, 0x9a, 0x3a,
+	0xcb, 0x9a, 0xb0, 0x6b, 0xa0, 0x8c, 0x18, 0x15, 0x1c, 0x22, 0x9d, 0xd1, 0x8a, 0xb7, 0xa0, 0xed,
+	0x07, 0xa0, 0x94, 0xce, 0xe3, 0x31, 0x8b, 0x54, 0xee, 0x2a, 0x9e, 0xa1, 0x6c, 0x07, 0xac, 0x05,
+	0x18, 0x91, 0x18, 0x46, 0x2a, 0x49, 0x1b, 0x5e, 0x46, 0x3e, 0x2f, 0x7f, 0xf6, 0x65, 0x23, 0xa7,
+	0x82, 0x78, 0x01, 0xd6, 0x97, 0x63, 0xb8, 0xb6, 0x6e, 0xbd, 0xcf, 0x7a, 0xfe, 0xa6, 0x75, 0xf7,
+	0xd7, 0x60, 0xbd, 0xcb, 0x49, 0x10, 0xe2, 0xe3, 0x0b, 0x22, 0xd0, 0xc4, 0xfe, 0x18, 0x54, 0x02,
+	0xc2, 0x31, 0x52, 0xf1, 0x49, 0x94, 0xcd, 0xdd, 0x87, 0x2d, 0x73, 0x89, 0xb4, 0x60, 0x3f, 0x3b,
+	0xf6, 0xae, 0x25, 0xaf, 0x0d, 0xe7, 0x97, 0x0d, 0x3f, 0x01, 0xc0, 0xb4, 0xbf, 0x4f, 0x02, 0x93,
+	0x91, 0x8a, 0xe1, 0x0c, 0x02, 0xf7, 0xaa, 0x00, 0x2a, 0x1e, 0x14, 0xf8, 0x80, 0xc4, 0x44, 0xbc,
+	0xc7, 0xf7, 0x9b, 0x10, 0xf9, 0x15, 0x08, 0x7b, 0x5f, 0xb7, 0x60, 0x82, 0x39, 0xc2, 0x54, 0xf8,
+	0x29, 0xa6, 0xc6, 0x4e, 0xf7, 0xc9, 0x57, 0xaf, 0x1b, 0xb9, 0xbf, 0xbf, 0x6e, 0x7c, 0x0f, 0xb1,
+	0x34, 0x66, 0x69, 0x1a, 0x9c, 0xb5, 0x08, 0x6b, 0xc7, 0x50, 0x4c, 0x5a, 0x03, 0x2a, 0x54, 0x87,
+	0x1e, 0x69, 0xad, 0x63, 0x4c, 0x6f, 0x01, 0x71, 0x8c, 0xce, 0x9d, 0xe2, 0x37, 0x04, 0xf2, 0x30,
+	0x3a, 0xb7, 0xf7, 0xc0, 0x96, 0x04, 0x82, 0x31, 0x9b, 0x66, 0x0e, 0xdd, 0xbb, 0x0b, 0xce, 0x46,
+	0x0c, 0x67, 0x1d, 0xa5, 0xa4, 0xfc, 0xb9, 0x09, 0xa3, 0xdc, 0x29, 0x7d, 0x33, 0x18, 0xe5, 0xcd,
+	0xcf, 0x40, 0xc9, 0xdc, 0x85, 0xb5, 0xa6, 0xb5, 0x53, 0xdd, 0x7d, 0xd4, 0xd2, 0x43, 0xac, 0x95,
+	0x0d, 0xb1, 0x56, 0xdf, 0x0c, 0xb9, 0x6e, 0x59, 0x02, 0x7f, 0xf1, 0x8f, 0x86, 0xe5, 0x19, 0x15,
+	0xf7, 0xcf, 0x79, 0xb0, 0xb9, 0xa8, 0xcf, 0x49, 0x0a, 0x43, 0xfc, 0xed, 0x8a, 0xf4, 0x31, 0x28,
+	0x11, 0x7a, 0x1a, 0xb1, 0x8b, 0xbb, 0x95, 0xc6, 0x08, 0xdb, 0x9f, 0x80, 0x35, 0x36, 0x15, 0x4a,
+	0xef, 0x4e, 0x95, 0xc8, 0xa4, 0xa5, 0xbd, 0x74, 0x9a, 0x24, 0xd1, 0xfc, 0x6e, 0x99, 0x37, 0xc2,
+	0xf6, 0x3e, 0x58, 0xd7, 0x81, 0xfb, 0xa9, 0x80, 0x5c, 0xa8, 0x7c, 0x57, 0x77, 0x6b, 0xb7, 0x32,
+	0x36, 0xca, 0xc6, 0xbe, 0x4e, 0xd9, 0xe7, 0x32, 0x65, 0x55, 0xad, 0x79, 0x2c, 0x15, 0xdd, 0x4f,
+	0x40, 0xd1, 0x63, 0x11, 0xb6, 0x6d, 0x50, 0xa4, 0x30, 0xce, 0x46, 0x88, 0xfa, 0xb6, 0x1f, 0x83,
+	0x4a, 0x36, 0xce, 0x52, 0x27, 0xaf, 0x46, 0x59, 0x39, 0xd6, 0x63, 0x2c, 0x75, 0x7f, 0x03, 0x2a,
+	0x52, 0x71, 0x9f, 0x43, 0x2a, 0xe4, 0xfd, 0x87, 0x41, 0xc0, 0x71, 0x9a, 0x1a, 0x80, 0x8c, 0x94,
+	0xb8, 0x9c, 0x45, 0xd8, 0x24, 0x5a, 0x7d, 0xdb, 0x2f, 0x00, 0xc0, 0xb3, 0x84, 0xe8, 0x5a, 0x3a,
+	0x85, 0xff, 0xeb, 0x7a, 0x51, 0xb9, 0xbd, 0xa4, 0xe3, 0xfe, 0xcb, 0x02, 0x5b, 0x2f, 0x19, 0xbf,
+	0x80, 0x3c, 0xc0, 0xc1, 0x11, 0x44, 0x67, 0x58, 0xac, 0x14, 0xd6, 0x5a, 0x2d, 0x6c, 0x0d, 0x94,
+	0x53, 0xfc, 0xab, 0x29, 0xa6, 0x08, 0x9b, 0xbd, 0xb3, 0xa0, 0xed, 0x67, 0xe0, 0x3b, 0x1c, 0x9f,
+	0x4e, 0x69, 0xe0, 0xdf, 0x1a, 0x01, 0x5b, 0xfa, 0xa0, 0xb7, 0xc0, 0xf9, 0x01, 0x30, 0x2c, 0xd9,
+	0xe8, 0x98, 0x9c, 0x63, 0x6e, 0xa6, 0xe4, 0xa6, 0x66, 0x7b, 0x86, 0x2b, 0x77, 0xa6, 0x11, 0x4c,
+	0x94, 0x83, 0xaa, 0xc0, 0xd5, 0xdd, 0xc7, 0x2d, 0x32, 0x46, 0x2d, 0xb9, 0x79, 0x5b, 0xd9, 0xba,
+	0x3d, 0xff, 0xb0, 0xa5, 0x63, 0xe8, 0x16, 0x65, 0x91, 0xbc, 0x75, 0xad, 0xa7, 0x79, 0xee, 0x1f,
+	0x2c, 0xb0, 0x21, 0x97, 0x4d, 0x27, 0x8a, 0xd8, 0x05, 0x94, 0xee, 0xca, 0x79, 0x2f, 0x27, 0xe9,
+	0x62, 0xde, 0x4b, 0x42, 0x72, 0xd9, 0x05, 0xc5, 0x3c, 0x1b, 0x6b, 0x8a, 0x90, 0x95, 0x49, 0x13,
+	0x4c, 0x03, 0xcc, 0x4d, 0x40, 0x19, 0x29, 0x3b, 0x4f, 0xdf, 0xd8, 0xbb, 0x75, 0xac, 0x11, 0x76,
+	0x7b, 0x60, 0xeb, 0x80, 0x85, 0xea, 0x5d, 0xd0, 0x25, 0x34, 0x30, 0x93, 0x3c, 0x91, 0x74, 0xe6,
+	0x8f, 0x22, 0x64, 0xc2, 0x21, 0x0f, 0xa7, 0x6a, 0x33, 0x9b, 0x49, 0x9e, 0xd1, 0xee, 0xef, 0x2c,
+	0xf0, 0xf0, 0x80, 0x85, 0xaf, 0x20, 0x0d, 0x22, 0xcc, 0x3b, 0x6a, 0x2e, 0x67, 0x68, 0x3f, 0x06,
+	0x25, 0xb8, 0x3c, 0xd2, 0x9d, 0x6c, 0xa4, 0xaf, 0x2a, 0x78, 0x46, 0x4e, 0x46, 0xa2, 0x4c, 0xea,
+	0x26, 0xad, 0x5e, 0x2f, 0x81, 0x15, 0x47, 0x4d, 0x7a, 0x8d, 0xb0, 0xfb, 0x5b, 0x00, 0xae, 0x21,
+	0x6f, 0x2c, 0x1e, 0x6b, 0x65, 0xed, 0xdd, 0x07, 0xf7, 0xf0, 0xf9, 0x75, 0x1c, 0x9a, 0xb0, 0x7f,
+	0x0e, 0xd6, 0xb4, 0x03, 0xa9, 0x53, 0x50, 0x76, 0x1b, 0xef, 0xf3, 0xf4, 0xa6, 0xfd, 0x4c, 0xcb,
+	0xfd, 0x53, 0x1e, 0x54, 0x5e, 0x42, 0x12, 0xe1, 0xe0, 0x80, 0x85, 0xf6, 0x43, 0xb0, 0x26, 0x66,
+	0xfe, 0x04, 0xa6, 0x13, 0x63, 0xbf, 0x24, 0x66, 0xaf, 0x60, 0x3a, 0x91, 0xd7, 0x50, 0xbe, 0x16,
+	0x08, 0x0d, 0xf0, 0x4c, 0x79, 0xb0, 0xe1, 0x95, 0x23, 0x16, 0x0e, 0x24, 0x7d, 0xc3, 0xed, 0xc2,
+	0xed, 0x6d, 0x2d, 0x58, 0x42, 0x50, 0xea, 0x14, 0xd5, 0xe5, 0x35, 0x94, 0xbc, 0x93, 0x01, 0x14,
+	0x50, 0x35, 0xe4, 0xba, 0xa7, 0xbe, 0x55, 0xb5, 0x84, 0x7a, 0xb3, 0xa5, 0x66, 0x85, 0x2f, 0x68,
+	0x79, 0x3d, 0x28, 0x9e, 0x09, 0xf3, 0x5e, 0x99, 0x60, 0x12, 0x4e, 0x84, 0x9a, 0xd1, 0x05, 0x6f,
+	0x4b, 0x1e, 0xa8, 0x07, 0xcb, 0x2b, 0xc5, 0x96, 0xd7, 0x23, 0xc0, 0x30, 0x88, 0x08, 0xc5, 0x99,
+	0x64, 0x59, 0x49, 0x6e, 0x66, 0x6c, 0x23, 0x28, 0x73, 0xca, 0x39, 0xe3, 0x4e, 0xc5, 0xe4, 0x54,
+	0x12, 0xea, 0x81, 0xa1, 0xbb, 0x15, 0x98, 0x07, 0x86, 0xa2, 0x9e, 0xfd, 0xd5, 0x02, 0x5b, 0x2b,
+	0x2b, 0xdd, 0x7e, 0x01, 0xbe, 0xdf, 0xf5, 0x06, 0xfd, 0xfd, 0x3d, 0xbf, 0x3f, 0xf0, 0xf6, 0x7a,
+	0xa3, 0xc1, 0xe1, 0xd0, 0x3f, 0x19, 0x1e, 0x1f, 0xed, 0xf5, 0x06, 0x2f, 0x07, 0x7b, 0xfd, 0xed,
+	0x5c, 0xad, 0x7e, 0x79, 0xd5, 0xac, 0xad, 0xa8, 0x9d, 0xd0, 0x34, 0xc1, 0x88, 0x9c, 0x12, 0x1c,
+	0xd8, 0x3f, 0x05, 0xce, 0x2d, 0x84, 0xc1, 0xb0, 0x7b, 0x78, 0x32, 0xec, 0x6f, 0x5b, 0xb5, 0xda,
+	0xe5, 0x55, 0xf3, 0xc1, 0x8a, 0xf6, 0x80, 0x8e, 0xd9, 0x94, 0x06, 0xf6, 0x73, 0xf0, 0xe8, 0x96,
+	0xe6, 0xe1, 0xc9, 0x48, 0xab, 0xe6, 0x6b, 0x8f, 0x2f, 0xaf, 0x9a, 0x0f, 0x57, 0x54, 0x0f, 0xa7,
+	0x42, 0xe9, 0xd6, 0x8a, 0x9f, 0xfd, 0xb1, 0x9e, 0x7b, 0xf6, 0x6f, 0x0b, 0x6c, 0xaf, 0xf6, 0x89,
+	0xdd, 0x03, 0xf5, 0x83, 0xc3, 0x7d, 0xff, 0x55, 0x67, 0xd8, 0x3f, 0xd8, 0xf3, 0xfc, 0xce, 0xbb,
+	0x82, 0x6a, 0x5c, 0x5e, 0x35, 0x1f, 0xaf, 0x6a, 0x2e, 0x47, 0x35, 0x00, 0xee, 0x3b, 0x40, 0x8e,
+	0xf7, 0x86, 0x7d, 0x7f, 0x74, 0xe8, 0x77, 0x7a, 0xbd, 0xc3, 0x93, 0xe1, 0x68, 0xdb, 0xaa, 0x3d,
+	0xbd, 0xbc, 0x6a, 0x3e, 0x59, 0x05, 0x92, 0x0b, 0x7d, 0xc4, 0x3a, 0x08, 0xc9, 0xcb, 0x6e, 0x77,
+	0x40, 0xfd, 0x7f, 0x40, 0x0d, 0xba, 0xbd, 0xed, 0x7c, 0xed, 0xc9, 0xe5, 0x55, 0xf3, 0xd1, 0xbb,
+	0x61, 0x06, 0x63, 0xa4, 0xa3, 0xed, 0x0e, 0xbf, 0x7a, 0x53, 0xb7, 0xbe, 0x7e, 0x53, 0xb7, 0xfe,
+	0xf9, 0xa6, 0x6e, 0x7d, 0xfe, 0xb6, 0x9e, 0xfb, 0xfa, 0x6d, 0x3d, 0xf7, 0xb7, 0xb7, 0xf5, 0xdc,
+	0x2f, 0x3f, 0x0a, 0x89, 0x98, 0x4c, 0xc7, 0x2d, 0xc4, 0xe2, 0x36, 0xe2, 0xf3, 0x44, 0xb0, 0x0f,
+	0x18, 0x0f, 0x3f, 0x40, 0x13, 0x48, 0xa8, 0xf9, 0x47, 0xd4, 0x3e, 0xdf, 0x6d, 0xcf, 0xb2, 0x6f,
+	0xb5, 0x8d, 0xc6, 0x25, 0xb5, 0x26, 0x7e, 0xf2, 0xdf, 0x01, 0x00, 0xf7, 0x4d, 0x94, 0xd6, 0x3b,
+	0x0d, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogRetryWindow != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.LogRetryWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ExecMsgTypeUrls) > 0 {
+		for iNdEx := len(m.ExecMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.ExecMsgTypeUrls[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.ExecMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EnableBankPrecompile {
+		i--
+		if m.EnableBankPrecompile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxCallbackGas != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.MaxCallbackGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EnableAutoDeployment {
+		i--
+		if m.EnableAutoDeployment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CronosAdmin) > 0 {
+		i -= len(m.CronosAdmin)
+		copy(dAtA[i:], m.CronosAdmin)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.CronosAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IbcTimeout != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.IbcTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IbcCroDenom) > 0 {
+		i -= len(m.IbcCroDenom)
+		copy(dAtA[i:], m.IbcCroDenom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.IbcCroDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMappingChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMappingChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMappingChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimal != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Decimal))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Direction != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCronos(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxAmountRecv.Size()
+		i -= size
+		if _, err := m.MaxAmountRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxAmountSend.Size()
+		i -= size
+		if _, err := m.MaxAmountSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCronos(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Role) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Role) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintCronos(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RefundPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RefundReceiver) > 0 {
+		i -= len(m.RefundReceiver)
+		copy(dAtA[i:], m.RefundReceiver)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.RefundReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BankAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BankAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BankAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCronos(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogParamBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogParamBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogParamBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Argument) > 0 {
+		i -= len(m.Argument)
+		copy(dAtA[i:], m.Argument)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Argument)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Param) > 0 {
+		i -= len(m.Param)
+		copy(dAtA[i:], m.Param)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Param)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogHandlerActionBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogHandlerActionBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogHandlerActionBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogHandler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogHandler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogHandler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronos(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintCronos(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintCronos(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintCronos(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCronos(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCronos(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcCroDenom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.IbcTimeout != 0 {
+		n += 1 + sovCronos(uint64(m.IbcTimeout))
+	}
+	l = len(m.CronosAdmin)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.EnableAutoDeployment {
+		n += 2
+	}
+	if m.MaxCallbackGas != 0 {
+		n += 1 + sovCronos(uint64(m.MaxCallbackGas))
+	}
+	if m.EnableBankPrecompile {
+		n += 2
+	}
+	if len(m.ExecMsgTypeUrls) > 0 {
+		for _, s := range m.ExecMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	if m.LogRetryWindow != 0 {
+		n += 1 + sovCronos(uint64(m.LogRetryWindow))
+	}
+	return n
+}
+
+func (m *TokenMappingChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.Decimal != 0 {
+		n += 1 + sovCronos(uint64(m.Decimal))
+	}
+	return n
+}
+
+func (m *TokenMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		n += 1 + sovCronos(uint64(m.Direction))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	l = m.MaxAmountSend.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	l = m.MaxAmountRecv.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovCronos(uint64(l))
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovCronos(uint64(l))
+	return n
+}
+
+func (m *Role) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCronos(uint64(m.Sequence))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.RefundReceiver)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = m.RefundPacket.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	return n
+}
+
+func (m *BankAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCronos(uint64(l))
+	return n
+}
+
+func (m *LogParamBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Param)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Argument)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
+}
+
+func (m *LogHandlerActionBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovCronos(uint64(m.Action))
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LogHandler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FailedLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovCronos(uint64(m.LogIndex))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovCronos(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovCronos(uint64(m.Attempts))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovCronos(uint64(m.NextRetryHeight))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovCronos(uint64(m.DeadlineHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovCronos(uint64(l))
+	}
+	return n
+}
+
+func sovCronos(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCronos(x uint64) (n int) {
+	return sovCronos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcCroDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcCroDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTimeout", wireType)
+			}
+			m.IbcTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronosAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronosAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAutoDeployment", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableAutoDeployment = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGas", wireType)
+			}
+			m.MaxCallbackGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableBankPrecompile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableBankPrecompile = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecMsgTypeUrls = append(m.ExecMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogRetryWindow", wireType)
+			}
+			m.LogRetryWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogRetryWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMappingChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMappingChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
+			}
+			m.Decimal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= BridgeDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Role) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BankAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BankAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BankAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogParamBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogParamBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogParamBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Param = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Argument", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Argument = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *LogHandlerActionBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -509,17 +4384,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogHandlerActionBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogHandlerActionBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcCroDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var stringLen uint64
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -529,29 +4404,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Action |= LogHandlerAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCronos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCronos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcCroDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcTimeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.IbcTimeout = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -561,14 +4423,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IbcTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, LogParamBinding{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogHandler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogHandler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogHandler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronosAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -596,13 +4523,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CronosAdmin = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableAutoDeployment", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -612,17 +4539,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableAutoDeployment = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronos
 			}
-			m.MaxCallbackGas = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -632,11 +4571,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCallbackGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronos
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, LogHandlerActionBinding{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCronos(dAtA[iNdEx:])
@@ -658,7 +4612,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
+func (m *FailedLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -681,15 +4635,15 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenMappingChangeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenMappingChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -717,13 +4671,13 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
 			}
-			var stringLen uint64
+			m.LogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -733,27 +4687,14 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LogIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCronos
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCronos
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -781,11 +4722,11 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -813,13 +4754,13 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -829,29 +4770,31 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCronos
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCronos
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			m.Decimal = 0
+			m.Attempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCronos
@@ -861,64 +4804,52 @@ func (m *TokenMappingChangeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimal |= uint32(b&0x7F) << shift
+				m.Attempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCronos(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCronos
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TokenMapping) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCronos
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TokenMapping: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -946,11 +4877,11 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -978,7 +4909,7 @@ func (m *TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
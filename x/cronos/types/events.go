@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,11 +16,37 @@ const (
 	AttributeKeyAmount                = "amount"
 	AttributeKeyReceiver              = "receiver"
 	AttributeKeyEthereumTokenContract = "ethereum_token_contract"
+	AttributeKeyEnable                = "enable"
+	AttributeKeyDirection             = "direction"
+	AttributeKeyDenom                 = "denom"
+	AttributeKeyChannelID             = "channel_id"
+	AttributeKeyAddress               = "address"
+	AttributeKeyRole                  = "role"
+	AttributeKeyExpiration            = "expiration"
+	AttributeKeyContract              = "contract"
+	AttributeKeyNewContract           = "new_contract"
+	AttributeKeyHolder                = "holder"
+	AttributeKeySequence              = "sequence"
+	AttributeKeyError                 = "error"
+	AttributeKeyTxHash                = "tx_hash"
+	AttributeKeyLogIndex              = "log_index"
+	AttributeKeyAttempts              = "attempts"
 
 	// events
 	EventTypeConvertVouchers             = "convert_vouchers"
 	EventTypeTransferTokens              = "transfer_tokens"
 	EventTypeEthereumSendToCosmosHandled = "ethereum_send_to_cosmos_handled"
+	EventTypeTurnBridge                  = "turn_bridge"
+	EventTypeGrantRole                   = "grant_role"
+	EventTypeRevokeRole                  = "revoke_role"
+	EventTypeMigrateTokenContract        = "migrate_token_contract"
+	EventTypeMigrateTokenBalance         = "migrate_token_balance"
+	EventTypeEvmHook                     = "evm_hook"
+	EventTypeIbcForward                  = "ibc_forward"
+	EventTypeIbcForwardRefund            = "ibc_forward_refund"
+	EventTypeLogRetryQueued              = "log_retry_queued"
+	EventTypeLogRetried                  = "log_retried"
+	EventTypeLogRefunded                 = "log_refunded"
 )
 
 // NewConvertVouchersEvent constructs a new voucher convert sdk.Event
@@ -31,6 +59,16 @@ func NewConvertVouchersEvent(sender string, amount fmt.Stringer) sdk.Event {
 	)
 }
 
+// NewConvertVouchersToEvent constructs a new voucher convert sdk.Event for a recipient in batch conversion
+func NewConvertVouchersToEvent(sender, receiver string, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeConvertVouchers,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
 // NewTransferTokensEvent constructs a new transfer sdk.Event
 func NewTransferTokensEvent(sender string, recipient string, amount fmt.Stringer) sdk.Event {
 	return sdk.NewEvent(
@@ -40,3 +78,104 @@ func NewTransferTokensEvent(sender string, recipient string, amount fmt.Stringer
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
+
+// NewTurnBridgeEvent constructs a new turn bridge sdk.Event
+func NewTurnBridgeEvent(sender string, enable bool, direction BridgeDirection, denom, channelID string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeTurnBridge,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyEnable, strconv.FormatBool(enable)),
+		sdk.NewAttribute(AttributeKeyDirection, direction.String()),
+		sdk.NewAttribute(AttributeKeyDenom, denom),
+		sdk.NewAttribute(AttributeKeyChannelID, channelID),
+	)
+}
+
+// NewGrantRoleEvent constructs a new role grant sdk.Event, the expiration is empty if the grant never expires.
+func NewGrantRoleEvent(address, role string, expiration *time.Time) sdk.Event {
+	var expirationStr string
+	if expiration != nil {
+		expirationStr = expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return sdk.NewEvent(
+		EventTypeGrantRole,
+		sdk.NewAttribute(AttributeKeyAddress, address),
+		sdk.NewAttribute(AttributeKeyRole, role),
+		sdk.NewAttribute(AttributeKeyExpiration, expirationStr),
+	)
+}
+
+// NewRevokeRoleEvent constructs a new role revocation sdk.Event
+func NewRevokeRoleEvent(address, role string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRevokeRole,
+		sdk.NewAttribute(AttributeKeyAddress, address),
+		sdk.NewAttribute(AttributeKeyRole, role),
+	)
+}
+
+// NewMigrateTokenContractEvent constructs a new token contract migration sdk.Event
+func NewMigrateTokenContractEvent(denom, contract, newContract string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeMigrateTokenContract,
+		sdk.NewAttribute(AttributeKeyDenom, denom),
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyNewContract, newContract),
+	)
+}
+
+// NewMigrateTokenBalanceEvent constructs a new token balance migration sdk.Event
+func NewMigrateTokenBalanceEvent(contract, newContract, holder string, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeMigrateTokenBalance,
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyNewContract, newContract),
+		sdk.NewAttribute(AttributeKeyHolder, holder),
+		sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewEvmHookEvent constructs a new evm hook sdk.Event
+func NewEvmHookEvent(sender, contract string, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeEvmHook,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewIbcForwardEvent constructs a new ibc forward sdk.Event
+func NewIbcForwardEvent(sender, receiver, channelID string, sequence uint64, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeIbcForward,
+		sdk.NewAttribute(AttributeKeySender, sender),
+		sdk.NewAttribute(AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewIbcForwardRefundEvent constructs a new ibc forward refund sdk.Event, the tokens are refunded to the receiver by
+// the counterparty chain of the channel.
+func NewIbcForwardRefundEvent(receiver, channelID string, amount fmt.Stringer) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeIbcForwardRefund,
+		sdk.NewAttribute(AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewFailedLogEvent constructs a new sdk.Event of a log in the retry queue, the error is empty if succeeded.
+func NewFailedLogEvent(eventType string, l FailedLog, err string) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeKeyTxHash, l.TxHash),
+		sdk.NewAttribute(AttributeKeyLogIndex, strconv.FormatUint(uint64(l.LogIndex), 10)),
+		sdk.NewAttribute(AttributeKeyContract, l.Contract),
+		sdk.NewAttribute(AttributeKeyAttempts, strconv.FormatUint(uint64(l.Attempts), 10)),
+		sdk.NewAttribute(AttributeKeyError, err),
+	)
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxLogRetryBackoff is the max number of blocks between the attempts of a failed log.
+	MaxLogRetryBackoff = 256
+	// MaxFailedLogsPerBlock is the max number of failed logs processed in an end blocker, the rest are postponed.
+	MaxFailedLogsPerBlock = 100
+	// MaxFailedLogsPerTx is the max number of failed logs an evm tx puts into the retry queue, otherwise it's reverted.
+	MaxFailedLogsPerTx = 10
+	// MaxFailedLogsPerSender is the max number of failed logs of a sender in the retry queue, otherwise the evm tx
+	// is reverted.
+	MaxFailedLogsPerSender = 20
+)
+
+// retryableLogErrors are the failures caused by the channel or bridge state, which could be resolved before the
+// deadline, the other failures revert the evm tx.
+var retryableLogErrors = []error{
+	ErrBridgeDisabled,
+	ErrRateLimitExceeded,
+	ibctransfertypes.ErrSendDisabled,
+	clienttypes.ErrClientNotActive,
+	connectiontypes.ErrConnectionNotFound,
+	connectiontypes.ErrInvalidConnectionState,
+	channeltypes.ErrChannelNotFound,
+	channeltypes.ErrInvalidChannelState,
+	channeltypes.ErrChannelCapabilityNotFound,
+	channeltypes.ErrSequenceSendNotFound,
+}
+
+// IsRetryableLogError returns if the failed native actions of an evm log could succeed on retry.
+func IsRetryableLogError(err error) bool {
+	for _, retryable := range retryableLogErrors {
+		if errors.Is(err, retryable) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewFailedLog constructs a failed log of the sender after the first attempt failed at the height.
+func NewFailedLog(
+	sender common.Address,
+	txHash common.Hash,
+	logIndex uint32,
+	log *ethtypes.Log,
+	height int64,
+	window uint64,
+	err error,
+) FailedLog {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Hex()
+	}
+	failed := FailedLog{
+		TxHash:         txHash.Hex(),
+		LogIndex:       logIndex,
+		Contract:       log.Address.Hex(),
+		Topics:         topics,
+		Data:           log.Data,
+		DeadlineHeight: height + int64(window),
+		Sender:         sender.Hex(),
+	}
+	failed.Fail(height, err)
+	return failed
+}
+
+// Fail records a failed attempt at the height and schedules the next one, the backoff doubles after each attempt, and
+// the next attempt is no later than the deadline.
+func (l *FailedLog) Fail(height int64, err error) {
+	backoff := int64(MaxLogRetryBackoff)
+	if l.Attempts < 8 {
+		backoff = 1 << l.Attempts
+	}
+	l.Attempts++
+	l.NextRetryHeight = min(height+backoff, l.DeadlineHeight)
+	l.Error = err.Error()
+}
+
+// EthLog returns the evm log to process.
+func (l FailedLog) EthLog() *ethtypes.Log {
+	topics := make([]common.Hash, len(l.Topics))
+	for i, topic := range l.Topics {
+		topics[i] = common.HexToHash(topic)
+	}
+	return &ethtypes.Log{
+		Address: common.HexToAddress(l.Contract),
+		Topics:  topics,
+		Data:    l.Data,
+		TxHash:  common.HexToHash(l.TxHash),
+		Index:   uint(l.LogIndex),
+	}
+}
+
+// Validate performs a stateless validation of the failed log.
+func (l FailedLog) Validate() error {
+	if len(common.FromHex(l.TxHash)) != common.HashLength {
+		return fmt.Errorf("invalid tx hash of failed log: %s", l.TxHash)
+	}
+	if !common.IsHexAddress(l.Contract) {
+		return fmt.Errorf("invalid contract address of failed log: %s", l.Contract)
+	}
+	if !common.IsHexAddress(l.Sender) {
+		return fmt.Errorf("invalid sender address of failed log: %s", l.Sender)
+	}
+	if len(l.Topics) == 0 {
+		return fmt.Errorf("no topics in failed log of tx %s", l.TxHash)
+	}
+	for _, topic := range l.Topics {
+		if len(common.FromHex(topic)) != common.HashLength {
+			return fmt.Errorf("invalid topic of failed log: %s", topic)
+		}
+	}
+	if l.NextRetryHeight > l.DeadlineHeight {
+		return fmt.Errorf("next retry height %d is after the deadline %d", l.NextRetryHeight, l.DeadlineHeight)
+	}
+	return nil
+}
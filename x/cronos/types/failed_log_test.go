@@ -0,0 +1,58 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailedLogBackoff(t *testing.T) {
+	log := &ethtypes.Log{
+		Address: common.HexToAddress(testToken),
+		Topics:  []common.Hash{common.BigToHash(common.Big1)},
+		Data:    []byte{1},
+	}
+	txHash := common.BigToHash(common.Big2)
+	sender := common.BigToAddress(common.Big3)
+	l := NewFailedLog(sender, txHash, 1, log, 100, 1000, errors.New("failed"))
+	require.NoError(t, l.Validate())
+	require.Equal(t, sender.Hex(), l.Sender)
+	require.Equal(t, uint32(1), l.Attempts)
+	require.Equal(t, int64(101), l.NextRetryHeight)
+	require.Equal(t, int64(1100), l.DeadlineHeight)
+	require.Equal(t, "failed", l.Error)
+
+	l.Fail(101, errors.New("failed again"))
+	require.Equal(t, int64(103), l.NextRetryHeight)
+	require.Equal(t, "failed again", l.Error)
+
+	// the backoff is capped
+	l.Attempts = 20
+	l.Fail(500, errors.New("failed"))
+	require.Equal(t, int64(500+MaxLogRetryBackoff), l.NextRetryHeight)
+	// no later than the deadline
+	l.Fail(1000, errors.New("failed"))
+	require.Equal(t, l.DeadlineHeight, l.NextRetryHeight)
+
+	ethLog := l.EthLog()
+	require.Equal(t, log.Address, ethLog.Address)
+	require.Equal(t, log.Topics, ethLog.Topics)
+	require.Equal(t, log.Data, ethLog.Data)
+	require.Equal(t, txHash, ethLog.TxHash)
+
+	l.Topics = nil
+	require.Error(t, l.Validate())
+}
+
+func TestIsRetryableLogError(t *testing.T) {
+	require.True(t, IsRetryableLogError(errorsmod.Wrap(ErrBridgeDisabled, "channel-0")))
+	require.True(t, IsRetryableLogError(errorsmod.Wrap(ErrRateLimitExceeded, "channel-0")))
+	require.True(t, IsRetryableLogError(errorsmod.Wrap(channeltypes.ErrChannelNotFound, "channel-0")))
+	require.False(t, IsRetryableLogError(errors.New("insufficient funds")))
+	require.False(t, IsRetryableLogError(errorsmod.Wrap(ErrIbcCroDenomInvalid, "denom")))
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 // this line is used by starport scaffolding # ibc/genesistype/import
 
@@ -22,5 +28,117 @@ func (gs GenesisState) Validate() error {
 
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	for _, s := range gs.DisabledBridges {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, l := range gs.RateLimits {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		key := string(RateLimitKey(l.Denom, l.ChannelId))
+		if seen[key] {
+			return fmt.Errorf("duplicated rate limit: denom %s, channel %s", l.Denom, l.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	roles := make(map[string]bool)
+	for _, r := range gs.Roles {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if roles[r.Name] {
+			return fmt.Errorf("duplicated role: %s", r.Name)
+		}
+		roles[r.Name] = true
+	}
+	grants := make(map[string]bool)
+	for _, g := range gs.RoleGrants {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+		if _, ok := GetBuiltinRole(g.Role); !ok && !roles[g.Role] {
+			return fmt.Errorf("role not found: %s", g.Role)
+		}
+		key := g.Address + "/" + g.Role
+		if grants[key] {
+			return fmt.Errorf("duplicated role grant: %s", key)
+		}
+		grants[key] = true
+	}
+
+	migrated := make(map[string]bool)
+	for _, m := range gs.MigratedContracts {
+		if !IsValidCoinDenom(m.Denom) {
+			return fmt.Errorf("invalid denom of migrated contract: %s", m.Denom)
+		}
+		if !common.IsHexAddress(m.Contract) {
+			return fmt.Errorf("invalid migrated contract address: %s", m.Contract)
+		}
+		contract := common.HexToAddress(m.Contract)
+		if migrated[contract.Hex()] {
+			return fmt.Errorf("duplicated migrated contract: %s", m.Contract)
+		}
+		migrated[contract.Hex()] = true
+	}
+
+	forwarded := make(map[string]bool)
+	for _, p := range gs.ForwardedPackets {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%d", p.ChannelId, p.Sequence)
+		if forwarded[key] {
+			return fmt.Errorf("duplicated forwarded packet: %s", key)
+		}
+		forwarded[key] = true
+	}
+
+	allowances := make(map[string]bool)
+	for _, a := range gs.BankAllowances {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		key := string(BankAllowanceKey(
+			common.HexToAddress(a.Token), common.HexToAddress(a.Owner), common.HexToAddress(a.Spender),
+		))
+		if allowances[key] {
+			return fmt.Errorf("duplicated bank allowance: token %s, owner %s, spender %s", a.Token, a.Owner, a.Spender)
+		}
+		allowances[key] = true
+	}
+
+	logHandlers := make(map[string]bool)
+	for _, h := range gs.LogHandlers {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+		event, err := h.ParseEvent()
+		if err != nil {
+			return err
+		}
+		key := string(LogHandlerKey(common.HexToAddress(h.Contract), event.ID))
+		if logHandlers[key] {
+			return fmt.Errorf("duplicated log handler: contract %s, event %s", h.Contract, event.Sig)
+		}
+		logHandlers[key] = true
+	}
+
+	failedLogs := make(map[string]bool)
+	for _, l := range gs.FailedLogs {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+		key := string(FailedLogKey(common.HexToHash(l.TxHash), l.LogIndex))
+		if failedLogs[key] {
+			return fmt.Errorf("duplicated failed log: tx %s, index %d", l.TxHash, l.LogIndex)
+		}
+		failedLogs[key] = true
+	}
+
 	return gs.Params.Validate()
 }
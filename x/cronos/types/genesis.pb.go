@@ -29,6 +29,24 @@ type GenesisState struct {
 	Params            Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	ExternalContracts []TokenMapping `protobuf:"bytes,2,rep,name=external_contracts,json=externalContracts,proto3" json:"external_contracts"`
 	AutoContracts     []TokenMapping `protobuf:"bytes,3,rep,name=auto_contracts,json=autoContracts,proto3" json:"auto_contracts"`
+	// disabled_bridges defines the bridge flows turned off.
+	DisabledBridges []BridgeSwitch `protobuf:"bytes,4,rep,name=disabled_bridges,json=disabledBridges,proto3" json:"disabled_bridges"`
+	// rate_limits defines the caps of the ibc transfers, the usages start over after genesis.
+	RateLimits []RateLimit `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// roles defines the custom roles, the built-in roles are not included.
+	Roles []Role `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles"`
+	// role_grants defines the roles granted to the accounts.
+	RoleGrants []RoleGrant `protobuf:"bytes,7,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	// migrated_contracts defines the paused contracts the denoms are migrated from.
+	MigratedContracts []TokenMapping `protobuf:"bytes,8,rep,name=migrated_contracts,json=migratedContracts,proto3" json:"migrated_contracts"`
+	// forwarded_packets defines the in-flight packets forwarding the tokens received from other chains.
+	ForwardedPackets []ForwardedPacket `protobuf:"bytes,9,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
+	// bank_allowances defines the allowances of the native coins of the evm tokens.
+	BankAllowances []BankAllowance `protobuf:"bytes,10,rep,name=bank_allowances,json=bankAllowances,proto3" json:"bank_allowances"`
+	// log_handlers defines the log handlers registered through governance.
+	LogHandlers []LogHandler `protobuf:"bytes,11,rep,name=log_handlers,json=logHandlers,proto3" json:"log_handlers"`
+	// failed_logs defines the evm logs in the retry queue.
+	FailedLogs []FailedLog `protobuf:"bytes,12,rep,name=failed_logs,json=failedLogs,proto3" json:"failed_logs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -85,6 +103,69 @@ func (m *GenesisState) GetAutoContracts() []TokenMapping {
 	return nil
 }
 
+func (m *GenesisState) GetDisabledBridges() []BridgeSwitch {
+	if m != nil {
+		return m.DisabledBridges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleGrants() []RoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigratedContracts() []TokenMapping {
+	if m != nil {
+		return m.MigratedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
+	if m != nil {
+		return m.ForwardedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBankAllowances() []BankAllowance {
+	if m != nil {
+		return m.BankAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLogHandlers() []LogHandler {
+	if m != nil {
+		return m.LogHandlers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedLogs() []FailedLog {
+	if m != nil {
+		return m.FailedLogs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cronos.GenesisState")
 }
@@ -92,24 +173,39 @@ func init() {
 func init() { proto.RegisterFile("cronos/genesis.proto", fileDescriptor_997c9bf6ad78cc99) }
 
 var fileDescriptor_997c9bf6ad78cc99 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x2e, 0xca, 0xcf,
-	0xcb, 0x2f, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x62, 0x83, 0x88, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x85, 0xf4, 0x41, 0x2c, 0x88,
-	0xac, 0x94, 0x30, 0x54, 0x0f, 0x84, 0x82, 0x08, 0x2a, 0x9d, 0x62, 0xe4, 0xe2, 0x71, 0x87, 0x18,
-	0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4, 0xc3, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xc4, 0xa7, 0x07, 0x55, 0x1f, 0x00, 0x16, 0x75, 0x62, 0x39,
-	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x46, 0xc8, 0x93, 0x4b, 0x28, 0xb5, 0xa2, 0x24, 0xb5, 0x28,
-	0x2f, 0x31, 0x27, 0x3e, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0xa4, 0x58, 0x82, 0x49, 0x81,
-	0x59, 0x83, 0xdb, 0x48, 0x04, 0xa6, 0x33, 0x24, 0x3f, 0x3b, 0x35, 0xcf, 0x37, 0xb1, 0xa0, 0x20,
-	0x33, 0x2f, 0x1d, 0xaa, 0x5f, 0x10, 0xa6, 0xcb, 0x19, 0xa6, 0x49, 0xc8, 0x91, 0x8b, 0x2f, 0xb1,
-	0xb4, 0x24, 0x1f, 0xc9, 0x18, 0x66, 0x82, 0xc6, 0xf0, 0x82, 0x74, 0xc0, 0x8d, 0x70, 0xf2, 0x3b,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x93, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xa2, 0xca, 0x82, 0x92, 0x7c, 0xdd, 0xfc, 0xa2, 0x74,
-	0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x68, 0x80, 0xe8, 0x97, 0x19, 0xe9, 0x57, 0xc0, 0xd8, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x30, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x58,
-	0x57, 0x45, 0x4d, 0x6e, 0x01, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x13, 0xda, 0x06, 0xb8, 0x84, 0xb4, 0x31, 0x41, 0x58, 0x1d, 0x4c, 0xc5, 0x94, 0x81,
+	0xc6, 0x52, 0x60, 0x40, 0x62, 0x6a, 0x80, 0x16, 0x50, 0x40, 0x55, 0xcb, 0xc4, 0x62, 0x9d, 0xed,
+	0xcb, 0xe5, 0x94, 0xcb, 0x3d, 0xeb, 0xde, 0x95, 0xb4, 0x33, 0xff, 0x00, 0x7f, 0x56, 0xc7, 0x8e,
+	0x4c, 0x08, 0x25, 0xff, 0x08, 0xf2, 0xf9, 0x2e, 0x4d, 0xba, 0x74, 0xf2, 0xe9, 0xfb, 0xde, 0xf7,
+	0x7b, 0xd2, 0x67, 0x3d, 0xd2, 0xcd, 0x34, 0x28, 0xc0, 0x98, 0x33, 0xc5, 0x50, 0x60, 0xbf, 0xd0,
+	0x60, 0x20, 0x68, 0x54, 0xea, 0x7e, 0x97, 0x03, 0x07, 0x2b, 0xc5, 0xe5, 0xab, 0x72, 0xf7, 0x9f,
+	0xba, 0x4c, 0xf5, 0xa9, 0xc4, 0x97, 0xbf, 0x1a, 0xa4, 0x75, 0x52, 0x41, 0xce, 0x0d, 0x35, 0x2c,
+	0x78, 0x45, 0x1a, 0x05, 0xd5, 0x74, 0x86, 0x61, 0xfd, 0xa0, 0xde, 0x6b, 0x0e, 0xda, 0x7d, 0x37,
+	0x7f, 0x6a, 0xd5, 0xe1, 0xf6, 0xf5, 0xdf, 0x17, 0xb5, 0x33, 0x37, 0x13, 0x7c, 0x26, 0x01, 0xbb,
+	0x34, 0x4c, 0x2b, 0x2a, 0x93, 0x0c, 0x94, 0xd1, 0x34, 0x33, 0x18, 0x3e, 0x38, 0xd8, 0xea, 0x35,
+	0x07, 0x5d, 0x9f, 0xfc, 0x0e, 0x53, 0xa6, 0xbe, 0xd2, 0xa2, 0x10, 0x8a, 0xbb, 0x7c, 0xc7, 0xa7,
+	0xde, 0xfb, 0x50, 0x70, 0x44, 0xda, 0xf4, 0xc2, 0xc0, 0x1a, 0x66, 0xeb, 0x5e, 0xcc, 0x93, 0x32,
+	0x71, 0x8b, 0xf8, 0x48, 0xf6, 0x72, 0x81, 0x34, 0x95, 0x2c, 0x4f, 0x52, 0x2d, 0x72, 0xce, 0x30,
+	0xdc, 0xde, 0x84, 0x0c, 0xad, 0x7c, 0x3e, 0x17, 0x26, 0x9b, 0x38, 0xc8, 0xae, 0xcf, 0x54, 0x1e,
+	0x06, 0x6f, 0x49, 0x53, 0x53, 0xc3, 0x12, 0x29, 0x66, 0xc2, 0x60, 0xb8, 0x63, 0x09, 0x1d, 0x4f,
+	0x38, 0xa3, 0x86, 0x8d, 0x4a, 0xc7, 0xc5, 0x89, 0xf6, 0x02, 0x06, 0x3d, 0xb2, 0xa3, 0x41, 0x32,
+	0x0c, 0x1b, 0x36, 0xd3, 0x5a, 0x65, 0x40, 0x32, 0x37, 0x5e, 0x0d, 0xd8, 0x1d, 0x20, 0x59, 0xc2,
+	0x35, 0x55, 0x06, 0xc3, 0x87, 0x77, 0x76, 0x80, 0x64, 0x27, 0xa5, 0xb3, 0xda, 0xe1, 0x05, 0x5b,
+	0xf9, 0x4c, 0xf0, 0x72, 0x69, 0xbe, 0xd6, 0xd5, 0xa3, 0xfb, 0x2b, 0xf7, 0xa9, 0xdb, 0xbe, 0xbe,
+	0x90, 0xce, 0x18, 0xf4, 0x9c, 0xea, 0x9c, 0xe5, 0x49, 0x41, 0xb3, 0x29, 0x33, 0x18, 0x3e, 0xb6,
+	0xa4, 0xe7, 0x9e, 0x74, 0xec, 0x07, 0x4e, 0xad, 0xef, 0x60, 0x7b, 0xe3, 0x4d, 0x19, 0x83, 0x0f,
+	0x64, 0x37, 0xa5, 0x6a, 0x9a, 0x50, 0x29, 0x61, 0x4e, 0x55, 0xc6, 0x30, 0x24, 0x96, 0xf4, 0x6c,
+	0x55, 0x3d, 0x55, 0xd3, 0x23, 0xef, 0x3a, 0x4e, 0x3b, 0x5d, 0x17, 0x31, 0x78, 0x47, 0x5a, 0x12,
+	0x78, 0x32, 0xa1, 0x2a, 0x97, 0x4c, 0x63, 0xd8, 0xb4, 0x88, 0xc0, 0x23, 0x46, 0xc0, 0x3f, 0x55,
+	0x96, 0xcb, 0x37, 0xe5, 0x4a, 0xb1, 0x9d, 0x8e, 0xa9, 0x28, 0x7f, 0xbe, 0x04, 0x8e, 0x61, 0x6b,
+	0xb3, 0xd3, 0x63, 0x6b, 0x8d, 0xc0, 0xf7, 0x41, 0xc6, 0x5e, 0xc0, 0xe1, 0xb7, 0xeb, 0x45, 0x54,
+	0xbf, 0x59, 0x44, 0xf5, 0x7f, 0x8b, 0xa8, 0xfe, 0x7b, 0x19, 0xd5, 0x6e, 0x96, 0x51, 0xed, 0xcf,
+	0x32, 0xaa, 0xfd, 0x78, 0xc3, 0x85, 0x99, 0x5c, 0xa4, 0xfd, 0x0c, 0x66, 0x71, 0xa6, 0xaf, 0x0a,
+	0x03, 0x87, 0xa0, 0xf9, 0x61, 0x36, 0xa1, 0x42, 0xb9, 0x4b, 0x8a, 0x7f, 0x0e, 0xe2, 0x4b, 0xff,
+	0x36, 0x57, 0x05, 0xc3, 0xb4, 0x61, 0x8f, 0xeb, 0xf5, 0xff, 0x01, 0x00, 0x21, 0xe7, 0xdc, 0x27,
+	0xa7, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +228,132 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedLogs) > 0 {
+		for iNdEx := len(m.FailedLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.LogHandlers) > 0 {
+		for iNdEx := len(m.LogHandlers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogHandlers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.BankAllowances) > 0 {
+		for iNdEx := len(m.BankAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BankAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ForwardedPackets) > 0 {
+		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MigratedContracts) > 0 {
+		for iNdEx := len(m.MigratedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigratedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DisabledBridges) > 0 {
+		for iNdEx := len(m.DisabledBridges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisabledBridges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AutoContracts) > 0 {
 		for iNdEx := len(m.AutoContracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -204,6 +426,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DisabledBridges) > 0 {
+		for _, e := range m.DisabledBridges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MigratedContracts) > 0 {
+		for _, e := range m.MigratedContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardedPackets) > 0 {
+		for _, e := range m.ForwardedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BankAllowances) > 0 {
+		for _, e := range m.BankAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogHandlers) > 0 {
+		for _, e := range m.LogHandlers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedLogs) > 0 {
+		for _, e := range m.FailedLogs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -343,6 +619,312 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledBridges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledBridges = append(m.DisabledBridges, BridgeSwitch{})
+			if err := m.DisabledBridges[len(m.DisabledBridges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, RoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigratedContracts = append(m.MigratedContracts, TokenMapping{})
+			if err := m.MigratedContracts[len(m.MigratedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPackets = append(m.ForwardedPackets, ForwardedPacket{})
+			if err := m.ForwardedPackets[len(m.ForwardedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankAllowances = append(m.BankAllowances, BankAllowance{})
+			if err := m.BankAllowances[len(m.BankAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogHandlers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogHandlers = append(m.LogHandlers, LogHandler{})
+			if err := m.LogHandlers[len(m.LogHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedLogs = append(m.FailedLogs, FailedLog{})
+			if err := m.FailedLogs[len(m.FailedLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,9 +3,17 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	testToken   = "0x0000000000000000000000000000000000000001"
+	testOwner   = "0x0000000000000000000000000000000000000002"
+	testSpender = "0x0000000000000000000000000000000000000003"
+	testTxHash  = "0x0000000000000000000000000000000000000000000000000000000000000001"
+)
+
 func TestGenesisStateValidate(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -28,6 +36,116 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid bank allowances",
+			GenesisState{
+				Params: DefaultParams(),
+				BankAllowances: []BankAllowance{
+					{Token: testToken, Owner: testOwner, Spender: testSpender, Amount: sdkmath.NewInt(1)},
+					{Token: testToken, Owner: testSpender, Spender: testOwner, Amount: sdkmath.NewInt(1)},
+				},
+			},
+			false,
+		},
+		{
+			"duplicated bank allowances",
+			GenesisState{
+				Params: DefaultParams(),
+				BankAllowances: []BankAllowance{
+					{Token: testToken, Owner: testOwner, Spender: testSpender, Amount: sdkmath.NewInt(1)},
+					{Token: testToken, Owner: testOwner, Spender: testSpender, Amount: sdkmath.NewInt(2)},
+				},
+			},
+			true,
+		},
+		{
+			"zero bank allowance",
+			GenesisState{
+				Params: DefaultParams(),
+				BankAllowances: []BankAllowance{
+					{Token: testToken, Owner: testOwner, Spender: testSpender, Amount: sdkmath.ZeroInt()},
+				},
+			},
+			true,
+		},
+		{
+			"invalid bank allowance address",
+			GenesisState{
+				Params: DefaultParams(),
+				BankAllowances: []BankAllowance{
+					{Token: "token", Owner: testOwner, Spender: testSpender, Amount: sdkmath.NewInt(1)},
+				},
+			},
+			true,
+		},
+		{
+			"valid log handlers",
+			GenesisState{
+				Params: DefaultParams(),
+				LogHandlers: []LogHandler{
+					{Contract: testToken, Event: testUnwrapEvent, Actions: []LogHandlerActionBinding{testSendToIbcAction()}},
+					{Contract: testOwner, Event: testUnwrapEvent, Actions: []LogHandlerActionBinding{testSendToIbcAction()}},
+				},
+			},
+			false,
+		},
+		{
+			"duplicated log handlers",
+			GenesisState{
+				Params: DefaultParams(),
+				LogHandlers: []LogHandler{
+					{Contract: testToken, Event: testUnwrapEvent, Actions: []LogHandlerActionBinding{testSendToIbcAction()}},
+					{Contract: testToken, Event: testUnwrapEvent, Actions: []LogHandlerActionBinding{testSendToIbcAction()}},
+				},
+			},
+			true,
+		},
+		{
+			"valid failed logs",
+			GenesisState{
+				Params: DefaultParams(),
+				FailedLogs: []FailedLog{
+					{TxHash: testTxHash, LogIndex: 0, Contract: testToken, Sender: testToken, Topics: []string{testTxHash}},
+					{TxHash: testTxHash, LogIndex: 1, Contract: testToken, Sender: testToken, Topics: []string{testTxHash}},
+				},
+			},
+			false,
+		},
+		{
+			"duplicated failed logs",
+			GenesisState{
+				Params: DefaultParams(),
+				FailedLogs: []FailedLog{
+					{TxHash: testTxHash, LogIndex: 0, Contract: testToken, Sender: testToken, Topics: []string{testTxHash}},
+					{TxHash: testTxHash, LogIndex: 0, Contract: testToken, Sender: testToken, Topics: []string{testTxHash}},
+				},
+			},
+			true,
+		},
+		{
+			"invalid failed log tx hash",
+			GenesisState{
+				Params:     DefaultParams(),
+				FailedLogs: []FailedLog{{TxHash: testToken, Contract: testToken, Sender: testToken, Topics: []string{testTxHash}}},
+			},
+			true,
+		},
+		{
+			"invalid failed log sender",
+			GenesisState{
+				Params:     DefaultParams(),
+				FailedLogs: []FailedLog{{TxHash: testTxHash, Contract: testToken, Topics: []string{testTxHash}}},
+			},
+			true,
+		},
+		{
+			"invalid log handler",
+			GenesisState{
+				Params:      DefaultParams(),
+				LogHandlers: []LogHandler{{Contract: testToken, Event: testUnwrapEvent}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+const (
+	// ForwardMemoKey is the key of the forward metadata in the json memo of ics20 packets
+	ForwardMemoKey = "forward"
+	// ForwardSenderPrefix is used to derive the intermediate senders of the forwarded transfers
+	ForwardSenderPrefix = "cronos-ibc-forward-intermediary"
+)
+
+// ForwardMetadata defines the next hop of the tokens received by the ics20 packet, the memo looks like:
+//
+//	{"forward": {"receiver": "cosmos1...", "port": "transfer", "channel": "channel-0", "timeout": "10m", "next": {...}}}
+//
+// The optional `next` is the memo of the forwarded packet, either a json object or a string, so the tokens can be
+// forwarded by the next chain again.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port,omitempty"`
+	Channel  string          `json:"channel"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// Validate performs a stateless validation of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return fmt.Errorf("forward receiver is empty")
+	}
+	if m.Port != "" && m.Port != ibctransfertypes.PortID {
+		return fmt.Errorf("forward port %s is not supported", m.Port)
+	}
+	if !channeltypes.IsValidChannelID(m.Channel) {
+		return fmt.Errorf("invalid forward channel %s", m.Channel)
+	}
+	if _, err := m.GetTimeout(); err != nil {
+		return err
+	}
+	_, err := m.GetNextMemo()
+	return err
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet, zero means the default one.
+func (m ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid forward timeout %s: %w", m.Timeout, err)
+	}
+	if timeout < 0 {
+		return 0, fmt.Errorf("negative forward timeout %s", m.Timeout)
+	}
+	return timeout, nil
+}
+
+// GetNextMemo returns the memo of the forwarded packet.
+func (m ForwardMetadata) GetNextMemo() (string, error) {
+	if len(m.Next) == 0 || bytes.Equal(m.Next, []byte("null")) {
+		return "", nil
+	}
+	var memo string
+	if err := json.Unmarshal(m.Next, &memo); err == nil {
+		return memo, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, m.Next); err != nil || !bytes.HasPrefix(buf.Bytes(), []byte("{")) {
+		return "", fmt.Errorf("forward next must be a json object or a string")
+	}
+	return buf.String(), nil
+}
+
+// Validate performs a stateless validation of the forwarded packet
+func (p ForwardedPacket) Validate() error {
+	if !channeltypes.IsValidChannelID(p.ChannelId) {
+		return fmt.Errorf("invalid channel of forwarded packet: %s", p.ChannelId)
+	}
+	if !channeltypes.IsValidChannelID(p.RefundChannelId) {
+		return fmt.Errorf("invalid refund channel of forwarded packet: %s", p.RefundChannelId)
+	}
+	if strings.TrimSpace(p.RefundReceiver) == "" {
+		return fmt.Errorf("refund receiver of forwarded packet is empty")
+	}
+	if err := p.RefundPacket.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid refund packet of forwarded packet: %w", err)
+	}
+	if p.RefundPacket.GetDestChannel() != p.RefundChannelId {
+		return fmt.Errorf("refund packet is not received from the refund channel: %s", p.RefundChannelId)
+	}
+	return nil
+}
+
+// ParseForwardMetadata parses the forward metadata from the ics20 memo, returns false if the memo is not a json
+// object or doesn't contain the forward key.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, bool, error) {
+	raw, ok := getMemoValue(memo, ForwardMemoKey)
+	if !ok {
+		return nil, false, nil
+	}
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return nil, true, fmt.Errorf("invalid forward metadata in memo: %w", err)
+	}
+	if err := metadata.Validate(); err != nil {
+		return nil, true, err
+	}
+	return &metadata, true, nil
+}
+
+// DeriveForwardSender derives the intermediate sender of the transfers forwarded from the original sender through the
+// channel, the tokens are received by it, and refunded to it if the forwarded transfer fails.
+func DeriveForwardSender(channelID, originalSender string) sdk.AccAddress {
+	return address.Hash(ForwardSenderPrefix, []byte(channelID+"/"+originalSender))
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		found    bool
+		expErr   bool
+		timeout  time.Duration
+		nextMemo string
+	}{
+		{"empty memo", "", false, false, 0, ""},
+		{"plain text memo", "hello", false, false, 0, ""},
+		{"other hooks", `{"evm": {"contract": "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"}}`, false, false, 0, ""},
+		{"valid", `{"forward": {"receiver": "cosmos1receiver", "channel": "channel-1"}}`, true, false, 0, ""},
+		{"valid with timeout", `{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1", "timeout": "10m"}}`, true, false, 10 * time.Minute, ""},
+		{"next object", `{"forward": {"receiver": "cosmos1receiver", "channel": "channel-1", "next": {"forward": {"receiver": "osmo1receiver", "channel": "channel-2"}}}}`, true, false, 0, `{"forward":{"receiver":"osmo1receiver","channel":"channel-2"}}`},
+		{"next string", `{"forward": {"receiver": "cosmos1receiver", "channel": "channel-1", "next": "hello"}}`, true, false, 0, "hello"},
+		{"empty receiver", `{"forward": {"channel": "channel-1"}}`, true, true, 0, ""},
+		{"invalid channel", `{"forward": {"receiver": "cosmos1receiver", "channel": "1"}}`, true, true, 0, ""},
+		{"invalid port", `{"forward": {"receiver": "cosmos1receiver", "port": "icahost", "channel": "channel-1"}}`, true, true, 0, ""},
+		{"invalid timeout", `{"forward": {"receiver": "cosmos1receiver", "channel": "channel-1", "timeout": "-1m"}}`, true, true, 0, ""},
+		{"invalid next", `{"forward": {"receiver": "cosmos1receiver", "channel": "channel-1", "next": 1}}`, true, true, 0, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, found, err := ParseForwardMetadata(tc.memo)
+			require.Equal(t, tc.found, found)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if !found {
+				return
+			}
+			timeout, err := metadata.GetTimeout()
+			require.NoError(t, err)
+			require.Equal(t, tc.timeout, timeout)
+			memo, err := metadata.GetNextMemo()
+			require.NoError(t, err)
+			require.Equal(t, tc.nextMemo, memo)
+		})
+	}
+}
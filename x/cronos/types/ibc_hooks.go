@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// EvmHookMemoKey is the key of the evm hook in the json memo of ics20 packets
+	EvmHookMemoKey = "evm"
+	// EvmHookSenderPrefix is used to derive the intermediate senders of the evm hooks
+	EvmHookSenderPrefix = "cronos-evm-hook-intermediary"
+)
+
+// EvmHook defines the contract call triggered by the received ics20 packet, the memo looks like:
+//
+//	{"evm": {"contract": "0x...", "data": "0x..."}}
+type EvmHook struct {
+	Contract common.Address `json:"contract"`
+	Data     hexutil.Bytes  `json:"data"`
+}
+
+// ParseEvmHook parses the evm hook from the ics20 memo, returns false if the memo is not a json object or
+// doesn't contain the evm hook key.
+func ParseEvmHook(memo string) (*EvmHook, bool, error) {
+	raw, ok := getMemoValue(memo, EvmHookMemoKey)
+	if !ok {
+		return nil, false, nil
+	}
+	var hook EvmHook
+	if err := json.Unmarshal(raw, &hook); err != nil {
+		return nil, true, fmt.Errorf("invalid evm hook in memo: %w", err)
+	}
+	if hook.Contract == (common.Address{}) {
+		return nil, true, fmt.Errorf("evm hook contract is empty")
+	}
+	return &hook, true, nil
+}
+
+// DeriveEvmHookSender derives the intermediate sender of the evm hooks triggered by the packets from the original
+// sender through the channel, the tokens are received by it, and it's the caller of the contract, so the contract
+// can't be called in the name of any other accounts.
+func DeriveEvmHookSender(channelID, originalSender string) common.Address {
+	return common.BytesToAddress(address.Hash(EvmHookSenderPrefix, []byte(channelID+"/"+originalSender)))
+}
+
+// getMemoValue returns the raw value of the key in the json memo of ics20 packets, returns false if the memo is not
+// a json object or doesn't contain the key.
+func getMemoValue(memo, key string) (json.RawMessage, bool) {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return nil, false
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &obj); err != nil {
+		return nil, false
+	}
+	raw, ok := obj[key]
+	return raw, ok
+}
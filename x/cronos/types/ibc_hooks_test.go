@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEvmHook(t *testing.T) {
+	contract := common.HexToAddress("0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2")
+	testCases := []struct {
+		name   string
+		memo   string
+		hook   *EvmHook
+		found  bool
+		expErr bool
+	}{
+		{"empty memo", "", nil, false, false},
+		{"plain text memo", "hello", nil, false, false},
+		{"invalid json", "{hello", nil, false, false},
+		{"other hooks", `{"wasm": {"contract": "abc"}}`, nil, false, false},
+		{"valid", `{"evm": {"contract": "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", "data": "0x1234"}}`, &EvmHook{Contract: contract, Data: []byte{0x12, 0x34}}, true, false},
+		{"empty data", `{"evm": {"contract": "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"}}`, &EvmHook{Contract: contract}, true, false},
+		{"invalid contract", `{"evm": {"contract": "0x57f96e"}}`, nil, true, true},
+		{"empty contract", `{"evm": {"data": "0x1234"}}`, nil, true, true},
+		{"invalid data", `{"evm": {"contract": "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", "data": "1234"}}`, nil, true, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hook, found, err := ParseEvmHook(tc.memo)
+			require.Equal(t, tc.found, found)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.hook, hook)
+		})
+	}
+}
+
+func TestDeriveEvmHookSender(t *testing.T) {
+	sender := DeriveEvmHookSender("channel-0", "crypto1sender")
+	require.Equal(t, sender, DeriveEvmHookSender("channel-0", "crypto1sender"))
+	require.NotEqual(t, sender, DeriveEvmHookSender("channel-1", "crypto1sender"))
+	require.NotEqual(t, sender, DeriveEvmHookSender("channel-0", "crypto1other"))
+}
@@ -4,6 +4,7 @@ import (
 	context "context"
 	"math/big"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -29,6 +31,9 @@ type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoins(ctx context.Context, senderAddr sdk.AccAddress, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 
 	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
@@ -38,6 +43,8 @@ type BankKeeper interface {
 type TransferKeeper interface {
 	Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error)
 	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool)
+	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
+	SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin)
 }
 
 // AccountKeeper defines the expected account keeper interface
@@ -57,6 +64,30 @@ type EvmLogHandler interface {
 		addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte)) error
 }
 
+// EvmLogHandlerRegistry defines the interface for the log handlers registered through governance
+type EvmLogHandlerRegistry interface {
+	// Process the log if a log handler is registered for the contract and the event, ignore it otherwise
+	Handle(ctx sdk.Context, contract common.Address, topics []common.Hash, data []byte,
+		addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte)) error
+}
+
+// EvmLogRefunder is implemented by the log handlers which can refund the tokens to the user when the native actions
+// keep failing
+type EvmLogRefunder interface {
+	// Refundable returns if all the native actions of the log can be refunded, only such failed logs are retried.
+	Refundable(ctx sdk.Context, contract common.Address, topics []common.Hash, data []byte) bool
+	Refund(ctx sdk.Context, contract common.Address, topics []common.Hash, data []byte) error
+}
+
+// EvmLogProcessor defines the interface to process a single evm log, used to retry the failed logs
+type EvmLogProcessor interface {
+	// Process the log with the matching handler
+	ProcessLog(ctx sdk.Context, log *ethtypes.Log,
+		addLogToReceipt func(contractAddress common.Address, logSig common.Hash, logData []byte)) error
+	// Refund the tokens to the user, if supported by the matching handler
+	RefundLog(ctx sdk.Context, log *ethtypes.Log) error
+}
+
 // EvmKeeper defines the interface for evm keeper
 type EvmKeeper interface {
 	GetNonce(ctx sdk.Context, addr common.Address) uint64
@@ -73,6 +104,18 @@ type EvmKeeper interface {
 // CronosKeeper defines the interface for cronos keeper
 type CronosKeeper interface {
 	GetParams(ctx sdk.Context) (params Params)
+	GetBankAllowance(ctx sdk.Context, token, owner, spender common.Address) *big.Int
+	SetBankAllowance(ctx sdk.Context, token, owner, spender common.Address, amount *big.Int)
+	SendTransfer(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		destination string,
+		coin sdk.Coin,
+		channelId string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		memo string,
+	) (uint64, error)
 }
 
 // IbcKeeper defines the interface for ibc keeper
@@ -100,3 +143,13 @@ type IbcFeeKeeper interface {
 	RegisterPayee(goCtx context.Context, msg *ibcfeetypes.MsgRegisterPayee) (*ibcfeetypes.MsgRegisterPayeeResponse, error)
 	RegisterCounterpartyPayee(goCtx context.Context, msg *ibcfeetypes.MsgRegisterCounterpartyPayee) (*ibcfeetypes.MsgRegisterCounterpartyPayeeResponse, error)
 }
+
+// ChannelKeeper defines the expected interface needed to query the state of the IBC channels.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool)
+}
+
+// MsgServiceRouter defines the expected interface needed to route the native messages to their handlers.
+type MsgServiceRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -28,6 +32,19 @@ const (
 	paramsKey
 	prefixAdminToPermissions
 	prefixBlockList
+	prefixBridgeSwitch
+	prefixRateLimit
+	prefixRateLimitUsage
+	prefixRateLimitPendingPacket
+	prefixRole
+	prefixRoleGrant
+	prefixMigratedContractToDenom
+	prefixForwardedPacket
+	prefixBankAllowance
+	prefixLogHandler
+	prefixFailedLog
+	prefixFailedLogQueue
+	prefixFailedLogSender
 )
 
 // KVStore key prefixes
@@ -39,6 +56,27 @@ var (
 	ParamsKey                   = []byte{paramsKey}
 	KeyPrefixAdminToPermissions = []byte{prefixAdminToPermissions}
 	KeyPrefixBlockList          = []byte{prefixBlockList}
+	KeyPrefixBridgeSwitch       = []byte{prefixBridgeSwitch}
+	KeyPrefixRateLimit          = []byte{prefixRateLimit}
+	KeyPrefixRateLimitUsage     = []byte{prefixRateLimitUsage}
+	// KeyPrefixRateLimitPendingPacket is the prefix of the outgoing packets accounted by rate limits
+	KeyPrefixRateLimitPendingPacket = []byte{prefixRateLimitPendingPacket}
+	KeyPrefixRole                   = []byte{prefixRole}
+	KeyPrefixRoleGrant              = []byte{prefixRoleGrant}
+	// KeyPrefixMigratedContractToDenom is the prefix of the paused contracts the denoms are migrated from
+	KeyPrefixMigratedContractToDenom = []byte{prefixMigratedContractToDenom}
+	// KeyPrefixForwardedPacket is the prefix of the in-flight packets forwarding the received tokens
+	KeyPrefixForwardedPacket = []byte{prefixForwardedPacket}
+	// KeyPrefixBankAllowance is the prefix of the allowances of the bank precompiled contract
+	KeyPrefixBankAllowance = []byte{prefixBankAllowance}
+	// KeyPrefixLogHandler is the prefix of the log handlers registered through governance
+	KeyPrefixLogHandler = []byte{prefixLogHandler}
+	// KeyPrefixFailedLog is the prefix of the evm logs in the retry queue
+	KeyPrefixFailedLog = []byte{prefixFailedLog}
+	// KeyPrefixFailedLogQueue is the prefix of the failed logs indexed by the height of the next attempt
+	KeyPrefixFailedLogQueue = []byte{prefixFailedLogQueue}
+	// KeyPrefixFailedLogSender is the prefix of the failed logs indexed by the sender of the evm tx
+	KeyPrefixFailedLogSender = []byte{prefixFailedLogSender}
 )
 
 // this line is used by starport scaffolding # ibc/keys/port
@@ -58,7 +96,135 @@ func ContractToDenomKey(contract []byte) []byte {
 	return append(KeyPrefixContractToDenom, contract...)
 }
 
-// AdminToPermissionsKey defines the store key for admin to permissions mapping
+// MigratedContractToDenomKey defines the store key for a paused contract to the denom migrated from it
+func MigratedContractToDenomKey(contract []byte) []byte {
+	return append(KeyPrefixMigratedContractToDenom, contract...)
+}
+
+// AdminToPermissionsKey defines the store key for admin to permissions mapping,
+// deprecated by the role grants, only used in migration.
 func AdminToPermissionsKey(address sdk.AccAddress) []byte {
 	return append(KeyPrefixAdminToPermissions, address.Bytes()...)
 }
+
+// BridgeSwitchKey defines the store key for a disabled bridge flow,
+// the direction must be specified, empty denom or channel id matches all of them.
+func BridgeSwitchKey(direction BridgeDirection, denom, channelID string) []byte {
+	key := append(KeyPrefixBridgeSwitch, byte(direction))
+	// the denom is no longer than 128 bytes, so the length fits in one byte
+	key = append(key, byte(len(denom)))
+	key = append(key, denom...)
+	return append(key, channelID...)
+}
+
+// ParseBridgeSwitchKey parses the bridge switch from the store key without prefix.
+func ParseBridgeSwitchKey(key []byte) BridgeSwitch {
+	denomLen := int(key[1])
+	return BridgeSwitch{
+		Direction: BridgeDirection(key[0]),
+		Denom:     string(key[2 : 2+denomLen]),
+		ChannelId: string(key[2+denomLen:]),
+	}
+}
+
+// RateLimitKey defines the store key for the rate limit of a denom through a channel,
+// empty channel id means all the channels.
+func RateLimitKey(denom, channelID string) []byte {
+	return denomChannelKey(KeyPrefixRateLimit, denom, channelID)
+}
+
+// RateLimitUsageKey defines the store key for the usage of a rate limit.
+func RateLimitUsageKey(denom, channelID string) []byte {
+	return denomChannelKey(KeyPrefixRateLimitUsage, denom, channelID)
+}
+
+// RateLimitPendingPacketKey defines the store key for an outgoing packet accounted by rate limits.
+func RateLimitPendingPacketKey(channelID string, sequence uint64) []byte {
+	key := append(KeyPrefixRateLimitPendingPacket, channelID...)
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
+
+// ForwardedPacketKey defines the store key for an in-flight packet forwarding the received tokens.
+func ForwardedPacketKey(channelID string, sequence uint64) []byte {
+	key := append(KeyPrefixForwardedPacket, channelID...)
+	return binary.BigEndian.AppendUint64(key, sequence)
+}
+
+// BankAllowanceKey defines the store key for the allowance of the native coins of an evm token.
+func BankAllowanceKey(token, owner, spender common.Address) []byte {
+	key := make([]byte, 0, len(KeyPrefixBankAllowance)+3*common.AddressLength)
+	key = append(key, KeyPrefixBankAllowance...)
+	key = append(key, token.Bytes()...)
+	key = append(key, owner.Bytes()...)
+	return append(key, spender.Bytes()...)
+}
+
+// LogHandlerKey defines the store key for the log handler of an event emitted by a contract.
+func LogHandlerKey(contract common.Address, eventID common.Hash) []byte {
+	key := make([]byte, 0, len(KeyPrefixLogHandler)+common.AddressLength+common.HashLength)
+	key = append(key, KeyPrefixLogHandler...)
+	key = append(key, contract.Bytes()...)
+	return append(key, eventID.Bytes()...)
+}
+
+// FailedLogKey defines the store key for a failed evm log in the retry queue.
+func FailedLogKey(txHash common.Hash, logIndex uint32) []byte {
+	key := make([]byte, 0, len(KeyPrefixFailedLog)+common.HashLength+4)
+	key = append(key, KeyPrefixFailedLog...)
+	key = append(key, txHash.Bytes()...)
+	return binary.BigEndian.AppendUint32(key, logIndex)
+}
+
+// FailedLogQueueKey defines the store key for a failed evm log indexed by the height of the next attempt.
+func FailedLogQueueKey(height int64, txHash common.Hash, logIndex uint32) []byte {
+	key := make([]byte, 0, len(KeyPrefixFailedLogQueue)+8+common.HashLength+4)
+	key = append(key, KeyPrefixFailedLogQueue...)
+	key = binary.BigEndian.AppendUint64(key, uint64(height))
+	key = append(key, txHash.Bytes()...)
+	return binary.BigEndian.AppendUint32(key, logIndex)
+}
+
+// FailedLogSenderPrefix defines the prefix of the failed evm logs of the sender.
+func FailedLogSenderPrefix(sender common.Address) []byte {
+	return append(append([]byte{}, KeyPrefixFailedLogSender...), sender.Bytes()...)
+}
+
+// FailedLogSenderKey defines the store key for a failed evm log indexed by the sender of the evm tx.
+func FailedLogSenderKey(sender common.Address, txHash common.Hash, logIndex uint32) []byte {
+	key := make([]byte, 0, len(KeyPrefixFailedLogSender)+common.AddressLength+common.HashLength+4)
+	key = append(key, KeyPrefixFailedLogSender...)
+	key = append(key, sender.Bytes()...)
+	key = append(key, txHash.Bytes()...)
+	return binary.BigEndian.AppendUint32(key, logIndex)
+}
+
+// ParseDenomChannelKey parses the denom and channel id from the store key without prefix,
+// see `RateLimitKey` for the layout.
+func ParseDenomChannelKey(key []byte) (string, string) {
+	denomLen := int(key[0])
+	return string(key[1 : 1+denomLen]), string(key[1+denomLen:])
+}
+
+func denomChannelKey(prefix []byte, denom, channelID string) []byte {
+	key := make([]byte, 0, len(prefix)+1+len(denom)+len(channelID))
+	key = append(key, prefix...)
+	// the denom is no longer than 128 bytes, so the length fits in one byte
+	key = append(key, byte(len(denom)))
+	key = append(key, denom...)
+	return append(key, channelID...)
+}
+
+// RoleKey defines the store key for a custom role
+func RoleKey(name string) []byte {
+	return append(KeyPrefixRole, name...)
+}
+
+// RoleGrantsPrefix defines the store key prefix for the role grants of an account
+func RoleGrantsPrefix(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixRoleGrant, address.MustLengthPrefix(addr)...)
+}
+
+// RoleGrantKey defines the store key for a role granted to an account
+func RoleGrantKey(addr sdk.AccAddress, role string) []byte {
+	return append(RoleGrantsPrefix(addr), role...)
+}
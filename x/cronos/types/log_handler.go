@@ -0,0 +1,136 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	LogParamSender    = "sender"
+	LogParamRecipient = "recipient"
+	LogParamAmount    = "amount"
+	LogParamChannelID = "channel_id"
+	LogParamMemo      = "memo"
+)
+
+type logHandlerParam struct {
+	name     string
+	typ      abi.Type
+	optional bool
+}
+
+// logHandlerActionParams defines the params of the native actions, bound to the event arguments of the same types.
+var logHandlerActionParams map[LogHandlerAction][]logHandlerParam
+
+func init() {
+	addressType, _ := abi.NewType("address", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	stringType, _ := abi.NewType("string", "", nil)
+
+	logHandlerActionParams = map[LogHandlerAction][]logHandlerParam{
+		LogHandlerActionSendToAccount: {
+			{name: LogParamRecipient, typ: addressType},
+			{name: LogParamAmount, typ: uint256Type},
+		},
+		LogHandlerActionSendToIbc: {
+			{name: LogParamSender, typ: addressType},
+			{name: LogParamRecipient, typ: stringType},
+			{name: LogParamAmount, typ: uint256Type},
+			{name: LogParamChannelID, typ: uint256Type, optional: true},
+			{name: LogParamMemo, typ: stringType, optional: true},
+		},
+	}
+}
+
+// ParseEvent parses the json abi of the event.
+func (h LogHandler) ParseEvent() (abi.Event, error) {
+	parsed, err := abi.JSON(bytes.NewReader([]byte("[" + h.Event + "]")))
+	if err != nil {
+		return abi.Event{}, fmt.Errorf("invalid event abi: %w", err)
+	}
+	if len(parsed.Events) != 1 || len(parsed.Methods) > 0 {
+		return abi.Event{}, fmt.Errorf("expect exactly one event in abi: %s", h.Event)
+	}
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			return abi.Event{}, fmt.Errorf("anonymous event not supported: %s", event.Name)
+		}
+		return event, nil
+	}
+	return abi.Event{}, fmt.Errorf("event not found in abi: %s", h.Event)
+}
+
+// Validate performs a stateless validation of the log handler, the params of the actions must be bound to the
+// arguments of the same types, the indexed arguments of dynamic types are not allowed since only the hashes are
+// logged.
+func (h LogHandler) Validate() error {
+	if !common.IsHexAddress(h.Contract) {
+		return fmt.Errorf("invalid contract address of log handler: %s", h.Contract)
+	}
+	event, err := h.ParseEvent()
+	if err != nil {
+		return err
+	}
+	if len(h.Actions) == 0 {
+		return fmt.Errorf("no actions in log handler of event %s", event.Name)
+	}
+	arguments := make(map[string]abi.Argument, len(event.Inputs))
+	for _, arg := range event.Inputs {
+		arguments[arg.Name] = arg
+	}
+	for _, action := range h.Actions {
+		params, ok := logHandlerActionParams[action.Action]
+		if !ok {
+			return fmt.Errorf("invalid log handler action: %s", action.Action)
+		}
+		known := make(map[string]struct{}, len(params))
+		for _, param := range params {
+			known[param.name] = struct{}{}
+		}
+		bindings := make(map[string]string, len(action.Params))
+		for _, binding := range action.Params {
+			if _, ok := known[binding.Param]; !ok {
+				return fmt.Errorf("unknown param %s of action %s", binding.Param, action.Action)
+			}
+			if _, ok := bindings[binding.Param]; ok {
+				return fmt.Errorf("duplicated param %s of action %s", binding.Param, action.Action)
+			}
+			bindings[binding.Param] = binding.Argument
+		}
+		for _, param := range params {
+			name, ok := bindings[param.name]
+			if !ok {
+				if param.optional {
+					continue
+				}
+				return fmt.Errorf("param %s of action %s is not bound", param.name, action.Action)
+			}
+			arg, ok := arguments[name]
+			if !ok {
+				return fmt.Errorf("argument %s not found in event %s", name, event.Name)
+			}
+			if arg.Type.String() != param.typ.String() {
+				return fmt.Errorf("argument %s of type %s can't be bound to param %s of type %s",
+					name, arg.Type, param.name, param.typ)
+			}
+			if arg.Indexed && (arg.Type.T == abi.StringTy || arg.Type.T == abi.BytesTy) {
+				return fmt.Errorf("indexed argument %s of dynamic type can't be bound", name)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateLogHandlerKey validates the contract and the event id of a log handler.
+func ValidateLogHandlerKey(contract, eventID string) error {
+	if !common.IsHexAddress(contract) {
+		return fmt.Errorf("invalid contract address of log handler: %s", contract)
+	}
+	if len(common.FromHex(eventID)) != common.HashLength {
+		return fmt.Errorf("invalid event id of log handler: %s", eventID)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testUnwrapEvent = `{"type":"event","name":"Unwrap","inputs":[` +
+	`{"name":"from","type":"address","indexed":true},` +
+	`{"name":"to","type":"string","indexed":false},` +
+	`{"name":"value","type":"uint256","indexed":false},` +
+	`{"name":"note","type":"string","indexed":true}]}`
+
+func testSendToIbcAction(params ...LogParamBinding) LogHandlerActionBinding {
+	return LogHandlerActionBinding{
+		Action: LogHandlerActionSendToIbc,
+		Params: append([]LogParamBinding{
+			{Param: LogParamSender, Argument: "from"},
+			{Param: LogParamRecipient, Argument: "to"},
+			{Param: LogParamAmount, Argument: "value"},
+		}, params...),
+	}
+}
+
+func TestLogHandlerValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		handler LogHandler
+		expErr  bool
+	}{
+		{
+			"valid",
+			LogHandler{Contract: testToken, Event: testUnwrapEvent, Actions: []LogHandlerActionBinding{testSendToIbcAction()}},
+			false,
+		},
+		{
+			"invalid contract",
+			LogHandler{Contract: "token", Event: testUnwrapEvent, Actions: []LogHandlerActionBinding{testSendToIbcAction()}},
+			true,
+		},
+		{
+			"invalid event abi",
+			LogHandler{Contract: testToken, Event: "{", Actions: []LogHandlerActionBinding{testSendToIbcAction()}},
+			true,
+		},
+		{
+			"not an event",
+			LogHandler{
+				Contract: testToken,
+				Event:    `{"type":"function","name":"unwrap","inputs":[]}`,
+				Actions:  []LogHandlerActionBinding{testSendToIbcAction()},
+			},
+			true,
+		},
+		{
+			"no actions",
+			LogHandler{Contract: testToken, Event: testUnwrapEvent},
+			true,
+		},
+		{
+			"unspecified action",
+			LogHandler{Contract: testToken, Event: testUnwrapEvent, Actions: []LogHandlerActionBinding{{}}},
+			true,
+		},
+		{
+			"unknown param",
+			LogHandler{
+				Contract: testToken,
+				Event:    testUnwrapEvent,
+				Actions:  []LogHandlerActionBinding{testSendToIbcAction(LogParamBinding{Param: "fee", Argument: "value"})},
+			},
+			true,
+		},
+		{
+			"duplicated param",
+			LogHandler{
+				Contract: testToken,
+				Event:    testUnwrapEvent,
+				Actions:  []LogHandlerActionBinding{testSendToIbcAction(LogParamBinding{Param: LogParamAmount, Argument: "value"})},
+			},
+			true,
+		},
+		{
+			"required param not bound",
+			LogHandler{
+				Contract: testToken,
+				Event:    testUnwrapEvent,
+				Actions: []LogHandlerActionBinding{{
+					Action: LogHandlerActionSendToAccount,
+					Params: []LogParamBinding{{Param: LogParamAmount, Argument: "value"}},
+				}},
+			},
+			true,
+		},
+		{
+			"argument not found",
+			LogHandler{
+				Contract: testToken,
+				Event:    testUnwrapEvent,
+				Actions:  []LogHandlerActionBinding{testSendToIbcAction(LogParamBinding{Param: LogParamMemo, Argument: "memo"})},
+			},
+			true,
+		},
+		{
+			"argument type mismatch",
+			LogHandler{
+				Contract: testToken,
+				Event:    testUnwrapEvent,
+				Actions: []LogHandlerActionBinding{{
+					Action: LogHandlerActionSendToAccount,
+					Params: []LogParamBinding{
+						{Param: LogParamRecipient, Argument: "to"},
+						{Param: LogParamAmount, Argument: "value"},
+					},
+				}},
+			},
+			true,
+		},
+		{
+			"indexed dynamic argument",
+			LogHandler{
+				Contract: testToken,
+				Event:    testUnwrapEvent,
+				Actions:  []LogHandlerActionBinding{testSendToIbcAction(LogParamBinding{Param: LogParamMemo, Argument: "note"})},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.handler.Validate()
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
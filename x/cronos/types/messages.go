@@ -2,6 +2,8 @@ package types
 
 import (
 	"bytes"
+	"fmt"
+	"time"
 
 	stderrors "errors"
 
@@ -9,6 +11,8 @@ import (
 	"filippo.io/age"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -16,12 +20,21 @@ const TypeMsgUpdateTokenMapping = "UpdateTokenMapping"
 
 var (
 	_ sdk.Msg = &MsgConvertVouchers{}
+	_ sdk.Msg = &MsgConvertVouchersBatch{}
 	_ sdk.Msg = &MsgTransferTokens{}
 	_ sdk.Msg = &MsgUpdateTokenMapping{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgTurnBridge{}
 	_ sdk.Msg = &MsgUpdatePermissions{}
 	_ sdk.Msg = &MsgStoreBlockList{}
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgSetRole{}
+	_ sdk.Msg = &MsgDeleteRole{}
+	_ sdk.Msg = &MsgGrantRole{}
+	_ sdk.Msg = &MsgRevokeRole{}
+	_ sdk.Msg = &MsgSetLogHandler{}
+	_ sdk.Msg = &MsgRemoveLogHandler{}
 )
 
 func NewMsgConvertVouchers(address string, coins sdk.Coins) *MsgConvertVouchers {
@@ -47,6 +60,35 @@ func (msg *MsgConvertVouchers) ValidateBasic() error {
 	return nil
 }
 
+// MaxConvertVouchersRecipients is the maximum number of recipients in a single MsgConvertVouchersBatch
+const MaxConvertVouchersRecipients = 500
+
+func NewMsgConvertVouchersBatch(sender string, recipients []VouchersRecipient) *MsgConvertVouchersBatch {
+	return &MsgConvertVouchersBatch{
+		Sender:     sender,
+		Recipients: recipients,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgConvertVouchersBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(msg.Recipients) == 0 || len(msg.Recipients) > MaxConvertVouchersRecipients {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "number of recipients must be between 1 and %d", MaxConvertVouchersRecipients)
+	}
+	for i, r := range msg.Recipients {
+		if !common.IsHexAddress(r.Address) {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address at index %d (%s)", i, r.Address)
+		}
+		if !r.Coins.IsValid() || !r.Coins.IsAllPositive() {
+			return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coins at index %d (%s)", i, r.Coins)
+		}
+	}
+	return nil
+}
+
 var _ sdk.Msg = &MsgTransferTokens{}
 
 func NewMsgTransferTokens(from string, to string, coins sdk.Coins) *MsgTransferTokens {
@@ -57,6 +99,20 @@ func NewMsgTransferTokens(from string, to string, coins sdk.Coins) *MsgTransferT
 	}
 }
 
+// NewMsgTransferTokensWithChannel creates a MsgTransferTokens with explicit source channel and timeouts.
+func NewMsgTransferTokensWithChannel(
+	from string, to string, coins sdk.Coins, channelID string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgTransferTokens {
+	return &MsgTransferTokens{
+		From:             from,
+		To:               to,
+		Coins:            coins,
+		ChannelId:        channelID,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
 // ValidateBasic ...
 func (msg *MsgTransferTokens) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.From)
@@ -73,6 +129,10 @@ func (msg *MsgTransferTokens) ValidateBasic() error {
 	if !msg.Coins.IsAllPositive() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Coins.String())
 	}
+
+	if msg.ChannelId != "" && !channeltypes.IsValidChannelID(msg.ChannelId) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel id (%s)", msg.ChannelId)
+	}
 	return nil
 }
 
@@ -122,13 +182,25 @@ func (msg MsgUpdateTokenMapping) Type() string {
 }
 
 // NewMsgTurnBridge ...
-func NewMsgTurnBridge(admin string, enable bool) *MsgTurnBridge {
+func NewMsgTurnBridge(admin string, enable bool, direction BridgeDirection, denom, channelID string) *MsgTurnBridge {
 	return &MsgTurnBridge{
-		Sender: admin,
-		Enable: enable,
+		Sender:    admin,
+		Enable:    enable,
+		Direction: direction,
+		Denom:     denom,
+		ChannelId: channelID,
 	}
 }
 
+// GetSigners ...
+func (msg *MsgTurnBridge) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 // ValidateBasic ...
 func (msg *MsgTurnBridge) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -136,7 +208,7 @@ func (msg *MsgTurnBridge) ValidateBasic() error {
 		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
 
-	return nil
+	return ValidateBridgeSwitch(msg.Direction, msg.Denom, msg.ChannelId)
 }
 
 func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
@@ -159,6 +231,205 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetRateLimit ...
+func NewMsgSetRateLimit(authority string, rateLimit RateLimit) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority: authority,
+		RateLimit: rateLimit,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	return msg.RateLimit.Validate()
+}
+
+// NewMsgRemoveRateLimit ...
+func NewMsgRemoveRateLimit(authority string, denom, channelID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	return ValidateRateLimitKey(msg.Denom, msg.ChannelId)
+}
+
+// NewMsgSetRole ...
+func NewMsgSetRole(from string, role Role) *MsgSetRole {
+	return &MsgSetRole{
+		From: from,
+		Role: role,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgSetRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return msg.Role.Validate()
+}
+
+// NewMsgDeleteRole ...
+func NewMsgDeleteRole(from string, name string) *MsgDeleteRole {
+	return &MsgDeleteRole{
+		From: from,
+		Name: name,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgDeleteRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateRoleName(msg.Name); err != nil {
+		return err
+	}
+	if _, ok := GetBuiltinRole(msg.Name); ok {
+		return fmt.Errorf("can't delete built-in role: %s", msg.Name)
+	}
+	return nil
+}
+
+// NewMsgGrantRole ...
+func NewMsgGrantRole(from string, address string, role string, expiration *time.Time) *MsgGrantRole {
+	return &MsgGrantRole{
+		From:       from,
+		Address:    address,
+		Role:       role,
+		Expiration: expiration,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgGrantRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid target address (%s)", err)
+	}
+	return ValidateRoleName(msg.Role)
+}
+
+// NewMsgRevokeRole ...
+func NewMsgRevokeRole(from string, address string, role string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		From:    from,
+		Address: address,
+		Role:    role,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgRevokeRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid target address (%s)", err)
+	}
+	return ValidateRoleName(msg.Role)
+}
+
+// MaxMigrateHolders is the maximum number of holders migrated in a single message
+const MaxMigrateHolders = 100
+
+// NewMsgMigrateTokenContract ...
+func NewMsgMigrateTokenContract(authority, denom, contract string) *MsgMigrateTokenContract {
+	return &MsgMigrateTokenContract{
+		Authority: authority,
+		Denom:     denom,
+		Contract:  contract,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgMigrateTokenContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if !IsValidCoinDenom(msg.Denom) || IsSourceCoin(msg.Denom) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom format (%s)", msg.Denom)
+	}
+	if msg.Contract != "" && !common.IsHexAddress(msg.Contract) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid contract address (%s)", msg.Contract)
+	}
+	return nil
+}
+
+// NewMsgMigrateTokenBalances ...
+func NewMsgMigrateTokenBalances(sender, contract string, holders []string) *MsgMigrateTokenBalances {
+	return &MsgMigrateTokenBalances{
+		Sender:   sender,
+		Contract: contract,
+		Holders:  holders,
+	}
+}
+
+// ValidateBasic ...
+func (msg *MsgMigrateTokenBalances) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if !common.IsHexAddress(msg.Contract) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid contract address (%s)", msg.Contract)
+	}
+	if len(msg.Holders) == 0 || len(msg.Holders) > MaxMigrateHolders {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "number of holders must be between 1 and %d", MaxMigrateHolders)
+	}
+	for _, holder := range msg.Holders {
+		if !common.IsHexAddress(holder) {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder address (%s)", holder)
+		}
+	}
+	return nil
+}
+
+// NewMsgSetLogHandler ...
+func NewMsgSetLogHandler(authority string, logHandler LogHandler) *MsgSetLogHandler {
+	return &MsgSetLogHandler{
+		Authority:  authority,
+		LogHandler: logHandler,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetLogHandler) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	return msg.LogHandler.Validate()
+}
+
+// NewMsgRemoveLogHandler ...
+func NewMsgRemoveLogHandler(authority, contract, eventID string) *MsgRemoveLogHandler {
+	return &MsgRemoveLogHandler{
+		Authority: authority,
+		Contract:  contract,
+		EventId:   eventID,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRemoveLogHandler) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	return ValidateLogHandlerKey(msg.Contract, msg.EventId)
+}
+
 // NewMsgUpdatePermissions ...
 func NewMsgUpdatePermissions(from string, address string, permissions uint64) *MsgUpdatePermissions {
 	return &MsgUpdatePermissions{
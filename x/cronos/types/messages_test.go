@@ -8,6 +8,7 @@ import (
 
 	"filippo.io/age"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	cmdcfg "github.com/crypto-org-chain/cronos/v2/cmd/cronosd/config"
 	"github.com/crypto-org-chain/cronos/v2/x/cronos/types"
 	"github.com/stretchr/testify/require"
@@ -123,3 +124,99 @@ func TestValidateMsgStoreBlockList(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMsgTransferTokens(t *testing.T) {
+	cmdcfg.SetBech32Prefixes(sdk.GetConfig())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ibc/0000000000000000000000000000000000000000000000000000000000000000", 1))
+	testCases := []struct {
+		name     string
+		msg      *types.MsgTransferTokens
+		expValid bool
+	}{
+		{
+			"valid without channel",
+			types.NewMsgTransferTokens("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "to", coins),
+			true,
+		},
+		{
+			"valid with channel",
+			types.NewMsgTransferTokensWithChannel("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "to", coins, "channel-1", clienttypes.NewHeight(1, 100), 0),
+			true,
+		},
+		{
+			"invalid channel",
+			types.NewMsgTransferTokensWithChannel("crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp", "to", coins, "aaa", clienttypes.ZeroHeight(), 0),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgConvertVouchersBatch(t *testing.T) {
+	cmdcfg.SetBech32Prefixes(sdk.GetConfig())
+
+	sender := "crc12luku6uxehhak02py4rcz65zu0swh7wjsrw0pp"
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ibc/0000000000000000000000000000000000000000000000000000000000000000", 1))
+	recipient := types.VouchersRecipient{Address: "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2", Coins: coins}
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgConvertVouchersBatch
+		expValid bool
+	}{
+		{
+			"valid",
+			types.NewMsgConvertVouchersBatch(sender, []types.VouchersRecipient{recipient, recipient}),
+			true,
+		},
+		{
+			"invalid sender",
+			types.NewMsgConvertVouchersBatch("crc12luku6uxehhak02py4r", []types.VouchersRecipient{recipient}),
+			false,
+		},
+		{
+			"empty recipients",
+			types.NewMsgConvertVouchersBatch(sender, nil),
+			false,
+		},
+		{
+			"too many recipients",
+			types.NewMsgConvertVouchersBatch(sender, make([]types.VouchersRecipient, types.MaxConvertVouchersRecipients+1)),
+			false,
+		},
+		{
+			"invalid recipient address",
+			types.NewMsgConvertVouchersBatch(sender, []types.VouchersRecipient{
+				recipient, {Address: sender, Coins: coins},
+			}),
+			false,
+		},
+		{
+			"empty coins",
+			types.NewMsgConvertVouchersBatch(sender, []types.VouchersRecipient{
+				{Address: recipient.Address},
+			}),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t1 *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expValid {
+				require.NoError(t1, err)
+			} else {
+				require.Error(t1, err)
+			}
+		})
+	}
+}
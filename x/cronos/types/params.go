@@ -2,11 +2,13 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 var (
@@ -20,6 +22,12 @@ var (
 	KeyEnableAutoDeployment = []byte("EnableAutoDeployment")
 	// KeyMaxCallbackGas is store's key for the MaxCallbackGas
 	KeyMaxCallbackGas = []byte("MaxCallbackGas")
+	// KeyEnableBankPrecompile is store's key for the EnableBankPrecompile
+	KeyEnableBankPrecompile = []byte("EnableBankPrecompile")
+	// KeyExecMsgTypeUrls is store's key for the ExecMsgTypeUrls
+	KeyExecMsgTypeUrls = []byte("ExecMsgTypeUrls")
+	// KeyLogRetryWindow is store's key for the LogRetryWindow
+	KeyLogRetryWindow = []byte("LogRetryWindow")
 )
 
 const (
@@ -34,13 +42,25 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the cronos module
-func NewParams(ibcCroDenom string, ibcTimeout uint64, cronosAdmin string, enableAutoDeployment bool, maxCallbackGas uint64) Params {
+func NewParams(
+	ibcCroDenom string,
+	ibcTimeout uint64,
+	cronosAdmin string,
+	enableAutoDeployment bool,
+	maxCallbackGas uint64,
+	enableBankPrecompile bool,
+	execMsgTypeUrls []string,
+	logRetryWindow uint64,
+) Params {
 	return Params{
 		IbcCroDenom:          ibcCroDenom,
 		IbcTimeout:           ibcTimeout,
 		CronosAdmin:          cronosAdmin,
 		EnableAutoDeployment: enableAutoDeployment,
 		MaxCallbackGas:       maxCallbackGas,
+		EnableBankPrecompile: enableBankPrecompile,
+		ExecMsgTypeUrls:      execMsgTypeUrls,
+		LogRetryWindow:       logRetryWindow,
 	}
 }
 
@@ -52,6 +72,7 @@ func DefaultParams() Params {
 		CronosAdmin:          "",
 		EnableAutoDeployment: false,
 		MaxCallbackGas:       MaxCallbackGasDefaultValue,
+		EnableBankPrecompile: false,
 	}
 }
 
@@ -71,6 +92,12 @@ func (p Params) Validate() error {
 	if err := validateIsUint64(p.MaxCallbackGas); err != nil {
 		return err
 	}
+	if err := validateMsgTypeUrls(p.ExecMsgTypeUrls); err != nil {
+		return err
+	}
+	if err := validateIsUint64(p.LogRetryWindow); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -88,6 +115,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCronosAdmin, &p.CronosAdmin, validateIsAddress),
 		paramtypes.NewParamSetPair(KeyEnableAutoDeployment, &p.EnableAutoDeployment, validateIsBool),
 		paramtypes.NewParamSetPair(KeyMaxCallbackGas, &p.MaxCallbackGas, validateIsUint64),
+		paramtypes.NewParamSetPair(KeyEnableBankPrecompile, &p.EnableBankPrecompile, validateIsBool),
+		paramtypes.NewParamSetPair(KeyExecMsgTypeUrls, &p.ExecMsgTypeUrls, validateMsgTypeUrls),
+		paramtypes.NewParamSetPair(KeyLogRetryWindow, &p.LogRetryWindow, validateIsUint64),
 	}
 }
 
@@ -130,3 +160,25 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMsgTypeUrls(i interface{}) error {
+	urls, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(urls))
+	for _, url := range urls {
+		if !strings.HasPrefix(url, "/") {
+			return fmt.Errorf("invalid msg type url: %s", url)
+		}
+		// the evm txs are not allowed to be nested in the evm calls
+		if url == sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}) {
+			return fmt.Errorf("msg type url not allowed: %s", url)
+		}
+		if _, ok := seen[url]; ok {
+			return fmt.Errorf("duplicated msg type url: %s", url)
+		}
+		seen[url] = struct{}{}
+	}
+	return nil
+}
@@ -95,3 +95,27 @@ func Test_validateIsAddress(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateMsgTypeUrls(t *testing.T) {
+	type args struct {
+		i interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"invalid type", args{"a"}, true},
+		{"empty list", args{[]string{}}, false},
+		{"correct type urls", args{[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}}, false},
+		{"invalid type url", args{[]string{"cosmos.bank.v1beta1.MsgSend"}}, true},
+		{"duplicated type url", args{[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}}, true},
+		{"evm tx not allowed", args{[]string{"/ethermint.evm.v1.MsgEthereumTx"}}, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateMsgTypeUrls(tt.args.i) != nil)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// ValidateRateLimitKey validates the denom and channel id of a rate limit, empty channel id is allowed.
+func ValidateRateLimitKey(denom, channelID string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if channelID != "" && !channeltypes.IsValidChannelID(channelID) {
+		return fmt.Errorf("invalid channel id: %s", channelID)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the rate limit
+func (l RateLimit) Validate() error {
+	if err := ValidateRateLimitKey(l.Denom, l.ChannelId); err != nil {
+		return err
+	}
+	for _, p := range []sdkmath.Int{l.MaxPercentSend, l.MaxPercentRecv} {
+		if p.IsNil() || p.IsNegative() || p.GT(sdkmath.NewInt(100)) {
+			return fmt.Errorf("invalid rate limit percentage: %s", p)
+		}
+	}
+	for _, a := range []sdkmath.Int{l.MaxAmountSend, l.MaxAmountRecv} {
+		if a.IsNil() || a.IsNegative() {
+			return fmt.Errorf("invalid rate limit amount: %s", a)
+		}
+	}
+	if l.Window <= 0 {
+		return fmt.Errorf("invalid rate limit window: %s", l.Window)
+	}
+	return nil
+}
+
+// Quota returns the cap of the flow given the supply at the start of the window,
+// the lower one of the percentage and the absolute cap is chosen, returns false if there's no limit.
+func (l RateLimit) Quota(direction BridgeDirection, supply sdkmath.Int) (sdkmath.Int, bool) {
+	maxPercent, maxAmount := l.MaxPercentRecv, l.MaxAmountRecv
+	if direction == BridgeDirectionOutbound {
+		maxPercent, maxAmount = l.MaxPercentSend, l.MaxAmountSend
+	}
+
+	var (
+		quota sdkmath.Int
+		found bool
+	)
+	if maxPercent.IsPositive() {
+		quota = supply.Mul(maxPercent).QuoRaw(100)
+		found = true
+	}
+	if maxAmount.IsPositive() && (!found || maxAmount.LT(quota)) {
+		quota = maxAmount
+		found = true
+	}
+	return quota, found
+}
+
+// NewRateLimitUsage creates an empty usage with the window starting at the block time.
+func NewRateLimitUsage(denom, channelID string, supply sdkmath.Int, windowStart time.Time) RateLimitUsage {
+	return RateLimitUsage{
+		Denom:       denom,
+		ChannelId:   channelID,
+		Inflow:      sdkmath.ZeroInt(),
+		Outflow:     sdkmath.ZeroInt(),
+		Supply:      supply,
+		WindowStart: windowStart,
+	}
+}
+
+// Flow returns the flow in the direction.
+func (u RateLimitUsage) Flow(direction BridgeDirection) sdkmath.Int {
+	if direction == BridgeDirectionOutbound {
+		return u.Outflow
+	}
+	return u.Inflow
+}
+
+// AddFlow adds the amount to the flow in the direction, the result is floored at zero.
+func (u *RateLimitUsage) AddFlow(direction BridgeDirection, amount sdkmath.Int) {
+	flow := u.Flow(direction).Add(amount)
+	if flow.IsNegative() {
+		flow = sdkmath.ZeroInt()
+	}
+	if direction == BridgeDirectionOutbound {
+		u.Outflow = flow
+	} else {
+		u.Inflow = flow
+	}
+}
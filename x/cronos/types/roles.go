@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The legacy permission bits of `MsgUpdatePermissions`, each one is mapped to a built-in role.
+const (
+	PermissionChangeTokenMapping uint64 = 1 << iota
+	PermissionTurnBridge
+)
+
+// the built-in role names
+const (
+	RoleTokenMappingOperator = "token_mapping_operator"
+	RoleBridgeOperator       = "bridge_operator"
+)
+
+// the type urls of the messages can be allowed in roles, they are constants because the package level
+// variables are initialized before the proto types are registered.
+const (
+	TypeURLMsgUpdateTokenMapping = "/cronos.MsgUpdateTokenMapping"
+	TypeURLMsgTurnBridge         = "/cronos.MsgTurnBridge"
+	TypeURLMsgStoreBlockList     = "/cronos.MsgStoreBlockList"
+)
+
+var (
+	// DelegatableMsgTypes are the message types can be allowed in roles, the role and permission management
+	// are always reserved for the cronos admin.
+	DelegatableMsgTypes = []string{
+		TypeURLMsgUpdateTokenMapping,
+		TypeURLMsgTurnBridge,
+		TypeURLMsgStoreBlockList,
+	}
+
+	// BuiltinRoles are the predefined roles, which can't be modified or deleted.
+	BuiltinRoles = []Role{
+		{Name: RoleTokenMappingOperator, MsgTypes: []string{TypeURLMsgUpdateTokenMapping}},
+		{Name: RoleBridgeOperator, MsgTypes: []string{TypeURLMsgTurnBridge}},
+	}
+
+	// PermissionRoles maps the legacy permission bits to the built-in roles.
+	PermissionRoles = []PermissionRole{
+		{Permission: PermissionChangeTokenMapping, Role: RoleTokenMappingOperator},
+		{Permission: PermissionTurnBridge, Role: RoleBridgeOperator},
+	}
+
+	roleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+)
+
+// PermissionRole defines the built-in role a legacy permission bit is mapped to.
+type PermissionRole struct {
+	Permission uint64
+	Role       string
+}
+
+// GetBuiltinRole returns the built-in role by name.
+func GetBuiltinRole(name string) (Role, bool) {
+	for _, role := range BuiltinRoles {
+		if role.Name == name {
+			return role, true
+		}
+	}
+	return Role{}, false
+}
+
+// ValidateRoleName validates the role name, which consists of lower case letters, digits and underscores.
+func ValidateRoleName(name string) error {
+	if !roleNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid role name: %s", name)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of a custom role
+func (r Role) Validate() error {
+	if err := ValidateRoleName(r.Name); err != nil {
+		return err
+	}
+	if _, ok := GetBuiltinRole(r.Name); ok {
+		return fmt.Errorf("can't modify built-in role: %s", r.Name)
+	}
+	if len(r.MsgTypes) == 0 {
+		return fmt.Errorf("empty message types in role: %s", r.Name)
+	}
+	seen := make(map[string]bool, len(r.MsgTypes))
+	for _, t := range r.MsgTypes {
+		if !isDelegatableMsgType(t) {
+			return fmt.Errorf("message type can't be delegated: %s", t)
+		}
+		if seen[t] {
+			return fmt.Errorf("duplicated message type: %s", t)
+		}
+		seen[t] = true
+	}
+	return nil
+}
+
+// AllowMsgType returns if the message type is allowed by the role.
+func (r Role) AllowMsgType(msgType string) bool {
+	for _, t := range r.MsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs a basic validation of the role grant
+func (g RoleGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return err
+	}
+	return ValidateRoleName(g.Role)
+}
+
+// IsActive returns if the grant is not expired at the time.
+func (g RoleGrant) IsActive(t time.Time) bool {
+	return g.Expiration == nil || t.Before(*g.Expiration)
+}
+
+func isDelegatableMsgType(msgType string) bool {
+	for _, t := range DelegatableMsgTypes {
+		if t == msgType {
+			return true
+		}
+	}
+	return false
+}